@@ -0,0 +1,62 @@
+// Команда ics-import загружает в сервис периоды недоступности из файла календаря iCalendar (.ics).
+//
+//	ics-import -file team-calendar.ics -url http://127.0.0.1:8080
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+)
+
+func main() {
+	baseURL := flag.String("url", "http://127.0.0.1:8080", "Service base URL.")
+	filePath := flag.String("file", "", "Path to iCalendar (.ics) file.")
+	timeout := flag.Duration("timeout", time.Minute, "Request timeout.")
+	flag.Parse()
+
+	if *filePath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	calendar, err := os.ReadFile(*filePath)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	client := api.NewClient(&http.Client{Timeout: *timeout}, strings.TrimRight(*baseURL, "/"))
+
+	result, err := client.Users().ImportUnavailability(ctx, users.ImportUnavailabilityParams{
+		Calendar: calendar,
+	})
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	//nolint:forbidigo
+	fmt.Printf(
+		"created: %d, updated: %d, deleted: %d, skipped events: %d\n",
+		result.Created,
+		result.Updated,
+		result.Deleted,
+		result.SkippedEvents,
+	)
+
+	if len(result.UnmatchedEmails) != 0 {
+		fmt.Printf("unmatched emails:\n\t%s\n", strings.Join(result.UnmatchedEmails, "\n\t")) //nolint:forbidigo
+	}
+}
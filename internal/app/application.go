@@ -34,7 +34,16 @@ func Run(ctx context.Context, cfg *koanf.Koanf) []error {
 		man := txman.New(database)
 
 		repo := postgres.NewRepository(man)
-		uc := usecase.New(repo, man)
+		uc, err := usecase.New(repo, man, cfg.Cut("assignment"))
+		if err != nil {
+			return err
+		}
+
+		err = initUnavailabilityHandOff(app, uc, cfg.Cut("availability"))
+		if err != nil {
+			return err
+		}
+
 		api := http2.NewAPI(cfg, uc, server)
 
 		api.Init()
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/knadh/koanf/v2"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/app"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// initUnavailabilityHandOff запускает фоновую задачу, которая раз в hand_off_interval передает
+// открытые ревью пользователей, у которых начался период недоступности, другим ревьюверам.
+// Нулевой интервал выключает задачу.
+func initUnavailabilityHandOff(a *app.App, uc *usecase.UseCase, cfg *koanf.Koanf) error {
+	interval := cfg.Duration("hand_off_interval")
+	if interval < 0 {
+		return fmt.Errorf("invalid unavailability hand off interval %s", interval)
+	}
+
+	if interval == 0 {
+		return nil
+	}
+
+	a.AfterInit(func() error {
+		a.Go(func() error {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-a.Done():
+					return nil
+				case <-ticker.C:
+					result, err := uc.HandOffUnavailableReviews(a)
+					if err != nil {
+						log.LoggerFromCtx(a).Error("error handing off unavailable reviews", zap.Error(err))
+					}
+
+					if result.Periods > 0 {
+						log.LoggerFromCtx(a).Info("unavailable reviews handed off",
+							zap.Int("Periods", result.Periods),
+							zap.Int("Reassigned", len(result.HandOff.Reassigned)),
+							zap.Int("NoCandidate", len(result.HandOff.NoCandidate)))
+					}
+				}
+			}
+		})
+
+		return nil
+	})
+
+	return nil
+}
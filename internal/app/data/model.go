@@ -25,19 +25,49 @@ type User struct {
 	ID         UserInternalID
 	ExternalID UserExternalID
 	Username   string
-	Email      string
-	IsActive   bool
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	// Email - адрес пользователя (пусто, если не указан).
+	Email    sql.NullString
+	IsActive bool
+	// MaxOpenReviews - лимит назначений на открытые PR (NULL - лимит команды или конфигурации).
+	MaxOpenReviews sql.Null[int]
+	// Timezone - часовой пояс IANA, в котором задан график работы (NULL - UTC).
+	Timezone  sql.NullString
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// UserFilter - условия выборки пользователей. Пустые (невалидные) поля не учитываются.
+type UserFilter struct {
+	IsActive sql.Null[bool]
+	// TeamID - команда, в которой состоит пользователь.
+	TeamID sql.Null[TeamInternalID]
+	// After - пользователь, после которого начинается страница (по возрастанию created_at, id).
+	After sql.Null[UserCursor]
+	Limit int
+}
+
+// UserCursor - позиция пользователя в выборке, упорядоченной по (created_at, id).
+type UserCursor struct {
+	CreatedAt time.Time
+	ID        UserInternalID
 }
 
 type Team struct {
-	ID          TeamInternalID
-	ExternalID  TeamExternalID
-	Name        string
-	Description string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID                TeamInternalID
+	ExternalID        TeamExternalID
+	Name              string
+	Description       string
+	ReviewerStrategy  sql.Null[string]
+	RequiredReviewers sql.Null[int]
+	LeadRule          sql.Null[string]
+	// MaxOpenReviews - лимит назначений на открытые PR для участников
+	// (NULL - значение из конфигурации).
+	MaxOpenReviews sql.Null[int]
+	// PreferWorkingHours - выбирать сначала ревьюверов в рабочих часах
+	// (NULL - значение из конфигурации).
+	PreferWorkingHours sql.Null[bool]
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
 }
 
 type TeamMember struct {
@@ -45,15 +75,67 @@ type TeamMember struct {
 	TeamID    TeamInternalID
 	UserID    UserInternalID
 	Role      string
+	IsPrimary bool
 	CreatedAt time.Time
 }
 
+type TeamFallback struct {
+	TeamID         TeamInternalID
+	FallbackTeamID TeamInternalID
+	Position       int
+	CreatedAt      time.Time
+}
+
+type ReviewerRule struct {
+	ID         uuid.UUID
+	AuthorID   UserInternalID
+	ReviewerID UserInternalID
+	Kind       string
+	CreatedAt  time.Time
+}
+
+// UnavailabilityPeriod - период, в который пользователь не назначается ревьювером.
+type UnavailabilityPeriod struct {
+	ID       uuid.UUID
+	UserID   UserInternalID
+	StartsAt time.Time
+	// EndsAt - конец периода (не включительно).
+	EndsAt time.Time
+	Reason sql.NullString
+	// HandedOffAt - время передачи открытых ревью пользователя после начала периода.
+	HandedOffAt sql.NullTime
+	// ExternalUID - UID события календаря, из которого импортирован период.
+	ExternalUID sql.NullString
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// WorkingHours - рабочий интервал пользователя в один из дней недели.
+type WorkingHours struct {
+	UserID UserInternalID
+	// Weekday - день недели по ISO 8601 (1 - понедельник, 7 - воскресенье).
+	Weekday int
+	// StartMinute, EndMinute - границы интервала в минутах от начала дня в часовом поясе
+	// пользователя; конец не включительно, 1440 - до конца дня.
+	StartMinute int
+	EndMinute   int
+}
+
+// UnavailabilityFilter - условия выборки периодов недоступности.
+// Пустые (невалидные) поля не учитываются.
+type UnavailabilityFilter struct {
+	UserID sql.Null[uuid.UUID]
+	// EndsAfter - только периоды, которые заканчиваются позже указанного времени.
+	EndsAfter sql.NullTime
+}
+
 type PullRequest struct {
 	ID                PullRequestInternalID
 	ExternalID        PullRequestExternalID
 	Title             string
 	Description       string
 	AuthorID          uuid.UUID
+	TeamID            sql.Null[TeamInternalID]
 	Status            string
 	NeedMoreReviewers bool
 	CreatedAt         time.Time
@@ -61,6 +143,29 @@ type PullRequest struct {
 	MergedAt          sql.NullTime
 }
 
+// PullRequestFilter - условия выборки PR. Пустые (невалидные) поля не учитываются.
+type PullRequestFilter struct {
+	Status   sql.Null[string]
+	AuthorID sql.Null[UserInternalID]
+	// ReviewerID - пользователь, назначенный текущим ревьювером PR.
+	ReviewerID        sql.Null[UserInternalID]
+	TeamID            sql.Null[TeamInternalID]
+	NeedMoreReviewers sql.Null[bool]
+	CreatedFrom       sql.NullTime
+	CreatedTo         sql.NullTime
+	MergedFrom        sql.NullTime
+	MergedTo          sql.NullTime
+	// After - PR, после которого начинается страница (по возрастанию created_at, id).
+	After sql.Null[PullRequestCursor]
+	Limit int
+}
+
+// PullRequestCursor - позиция PR в выборке, упорядоченной по (created_at, id).
+type PullRequestCursor struct {
+	CreatedAt time.Time
+	ID        PullRequestInternalID
+}
+
 type PRReviewer struct {
 	ID            PRReviewerInternalID
 	PullRequestID PullRequestInternalID
@@ -71,12 +176,54 @@ type PRReviewer struct {
 	IsCurrent     bool
 }
 
+// ReviewAssignment - текущее назначение ревьювера вместе с данными PR.
+type ReviewAssignment struct {
+	ID                    PRReviewerInternalID
+	AssignedAt            time.Time
+	PullRequestExternalID string
+	PullRequestTitle      string
+	PullRequestStatus     string
+	AuthorExternalID      string
+}
+
+// ReviewAssignmentFilter - условия выборки текущих назначений ревьювера.
+type ReviewAssignmentFilter struct {
+	ReviewerID UserInternalID
+	// Status - статус PR (не учитывается, если пуст).
+	Status sql.Null[string]
+	// After - назначение, после которого начинается страница (по возрастанию assigned_at, id).
+	After sql.Null[ReviewAssignmentCursor]
+	Limit int
+}
+
+// ReviewAssignmentCursor - позиция назначения в выборке, упорядоченной по (assigned_at, id).
+type ReviewAssignmentCursor struct {
+	AssignedAt time.Time
+	ID         PRReviewerInternalID
+}
+
+// TeamPullRequestCount - количество PR команды.
+type TeamPullRequestCount struct {
+	TeamID   TeamInternalID
+	TotalPRs int64
+	OpenPRs  int64
+}
+
+// ReviewerLoad - агрегированная нагрузка ревьювера.
+type ReviewerLoad struct {
+	ReviewerID     UserInternalID
+	OpenReviews    int64
+	LastAssignedAt sql.NullTime
+}
+
 type PRReviewerHistory struct {
 	ID            PRReviewerHistoryInternalID
 	PullRequestID PullRequestInternalID
 	OldReviewerID sql.Null[UserInternalID]
-	NewReviewerID UserInternalID
+	NewReviewerID sql.Null[UserInternalID]
 	ChangedBy     sql.Null[UserInternalID]
 	ChangedAt     time.Time
 	Reason        string
+	// Comment - причина изменения в свободной форме, указанная инициатором.
+	Comment sql.NullString
 }
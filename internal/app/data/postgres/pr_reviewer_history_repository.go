@@ -32,7 +32,7 @@ func (r *PRReviewerHistoryRepository) GetPRReviewerHistoryByID(
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		SELECT id, pr_id, old_reviewer_id, new_reviewer_id, changed_by, changed_at, reason
+		SELECT id, pr_id, old_reviewer_id, new_reviewer_id, changed_by, changed_at, reason, comment
 		FROM pr_reviewer_history
 		WHERE id = $1
 		`,
@@ -45,6 +45,7 @@ func (r *PRReviewerHistoryRepository) GetPRReviewerHistoryByID(
 		&history.ChangedBy,
 		&history.ChangedAt,
 		&history.Reason,
+		&history.Comment,
 	)
 	if err != nil {
 		if goerrors.Is(err, sql.ErrNoRows) {
@@ -66,9 +67,11 @@ func (r *PRReviewerHistoryRepository) CreatePRReviewerHistory(
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		INSERT INTO pr_reviewer_history (id, pr_id, old_reviewer_id, new_reviewer_id, changed_by, changed_at, reason)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, pr_id, old_reviewer_id, new_reviewer_id, changed_by, changed_at, reason
+		INSERT INTO pr_reviewer_history (
+			id, pr_id, old_reviewer_id, new_reviewer_id, changed_by, changed_at, reason, comment
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, pr_id, old_reviewer_id, new_reviewer_id, changed_by, changed_at, reason, comment
 		`,
 		history.ID,
 		history.PullRequestID,
@@ -77,6 +80,7 @@ func (r *PRReviewerHistoryRepository) CreatePRReviewerHistory(
 		history.ChangedBy,
 		history.ChangedAt,
 		history.Reason,
+		history.Comment,
 	).Scan(
 		&createdHistory.ID,
 		&createdHistory.PullRequestID,
@@ -85,6 +89,7 @@ func (r *PRReviewerHistoryRepository) CreatePRReviewerHistory(
 		&createdHistory.ChangedBy,
 		&createdHistory.ChangedAt,
 		&createdHistory.Reason,
+		&createdHistory.Comment,
 	)
 	if err != nil {
 		return data.PRReviewerHistory{}, errors.Wrap(err, errors.InternalError)
@@ -93,7 +98,7 @@ func (r *PRReviewerHistoryRepository) CreatePRReviewerHistory(
 	return createdHistory, nil
 }
 
-// GetPRReviewerHistory возвращает историю изменений для PR
+// GetPRReviewerHistory возвращает историю изменений для PR в хронологическом порядке
 func (r *PRReviewerHistoryRepository) GetPRReviewerHistory(
 	ctx context.Context,
 	prID uuid.UUID,
@@ -101,10 +106,10 @@ func (r *PRReviewerHistoryRepository) GetPRReviewerHistory(
 	rows, err := r.txMan.Executor(ctx).QueryContext(
 		ctx,
 		`
-		SELECT id, pr_id, old_reviewer_id, new_reviewer_id, changed_by, changed_at, reason
+		SELECT id, pr_id, old_reviewer_id, new_reviewer_id, changed_by, changed_at, reason, comment
 		FROM pr_reviewer_history
 		WHERE pr_id = $1
-		ORDER BY changed_at DESC
+		ORDER BY changed_at
 		`,
 		prID,
 	)
@@ -126,6 +131,7 @@ func (r *PRReviewerHistoryRepository) GetPRReviewerHistory(
 			&entry.ChangedBy,
 			&entry.ChangedAt,
 			&entry.Reason,
+			&entry.Comment,
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, errors.InternalError)
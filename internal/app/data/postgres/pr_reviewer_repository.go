@@ -175,6 +175,73 @@ func (r *PRReviewerRepository) GetUserAssignedPRs(
 	return reviewers, nil
 }
 
+// GetReviewAssignments возвращает страницу текущих назначений ревьювера по возрастанию (assigned_at, id)
+func (r *PRReviewerRepository) GetReviewAssignments(
+	ctx context.Context,
+	filter data.ReviewAssignmentFilter,
+) ([]data.ReviewAssignment, error) {
+	var (
+		afterAssignedAt sql.NullTime
+		afterID         uuid.NullUUID
+	)
+
+	if filter.After.Valid {
+		afterAssignedAt = sql.NullTime{Time: filter.After.V.AssignedAt, Valid: true}
+		afterID = uuid.NullUUID{UUID: filter.After.V.ID, Valid: true}
+	}
+
+	rows, err := r.txMan.Executor(ctx).QueryContext(
+		ctx,
+		`
+		SELECT r.id, r.assigned_at, pr.external_id, pr.title, pr.status, u.external_id
+		FROM pr_reviewers r
+		JOIN pull_requests pr ON pr.id = r.pr_id
+		JOIN users u ON u.id = pr.author_id
+		WHERE r.reviewer_id = $1
+			AND r.is_current = true
+			AND ($2::varchar IS NULL OR pr.status = $2)
+			AND ($3::timestamp IS NULL OR (r.assigned_at, r.id) > ($3, $4))
+		ORDER BY r.assigned_at, r.id
+		LIMIT $5
+		`,
+		filter.ReviewerID,
+		filter.Status,
+		afterAssignedAt,
+		afterID,
+		filter.Limit,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var assignments []data.ReviewAssignment
+	for rows.Next() {
+		var assignment data.ReviewAssignment
+		err := rows.Scan(
+			&assignment.ID,
+			&assignment.AssignedAt,
+			&assignment.PullRequestExternalID,
+			&assignment.PullRequestTitle,
+			&assignment.PullRequestStatus,
+			&assignment.AuthorExternalID,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InternalError)
+		}
+		assignments = append(assignments, assignment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	return assignments, nil
+}
+
 func (r *PRReviewerRepository) UpdatePRReviewer(
 	ctx context.Context,
 	reviewer data.PRReviewer,
@@ -210,3 +277,77 @@ func (r *PRReviewerRepository) UpdatePRReviewer(
 
 	return updatedReviewer, nil
 }
+
+func (r *PRReviewerRepository) GetReviewersLoad(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+) ([]data.ReviewerLoad, error) {
+	ids := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		ids = append(ids, id.String())
+	}
+
+	rows, err := r.txMan.Executor(ctx).QueryContext(
+		ctx,
+		`
+		SELECT u.id,
+		       COUNT(pr.id) FILTER (WHERE r.is_current = true AND pr.status = 'OPEN'),
+		       MAX(r.assigned_at)
+		FROM unnest($1::uuid[]) AS u(id)
+		LEFT JOIN pr_reviewers r ON r.reviewer_id = u.id
+		LEFT JOIN pull_requests pr ON pr.id = r.pr_id
+		GROUP BY u.id
+		`,
+		ids,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	loads := make([]data.ReviewerLoad, 0, len(userIDs))
+	for rows.Next() {
+		var load data.ReviewerLoad
+		err := rows.Scan(
+			&load.ReviewerID,
+			&load.OpenReviews,
+			&load.LastAssignedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InternalError)
+		}
+		loads = append(loads, load)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	return loads, nil
+}
+
+// CountTeamAssignments возвращает количество текущих назначений, сделанных из команды
+func (r *PRReviewerRepository) CountTeamAssignments(
+	ctx context.Context,
+	teamID uuid.UUID,
+) (int64, error) {
+	var count int64
+
+	err := r.txMan.Executor(ctx).QueryRowContext(
+		ctx,
+		`
+		SELECT COUNT(*)
+		FROM pr_reviewers
+		WHERE team_id = $1 AND is_current = true
+		`,
+		teamID,
+	).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.InternalError)
+	}
+
+	return count, nil
+}
@@ -3,6 +3,8 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -32,7 +34,7 @@ func (r *PullRequestRepository) GetPullRequestByID(
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		SELECT id, external_id, title, description, author_id, status, need_more_reviewers, created_at, updated_at, merged_at
+		SELECT id, external_id, title, description, author_id, team_id, status, need_more_reviewers, created_at, updated_at, merged_at
 		FROM pull_requests
 		WHERE id = $1
 		`,
@@ -43,6 +45,7 @@ func (r *PullRequestRepository) GetPullRequestByID(
 		&pr.Title,
 		&pr.Description,
 		&pr.AuthorID,
+		&pr.TeamID,
 		&pr.Status,
 		&pr.NeedMoreReviewers,
 		&pr.CreatedAt,
@@ -68,7 +71,7 @@ func (r *PullRequestRepository) GetPullRequestByExternalID(
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		SELECT id, external_id, title, description, author_id, status, need_more_reviewers, created_at, updated_at, merged_at
+		SELECT id, external_id, title, description, author_id, team_id, status, need_more_reviewers, created_at, updated_at, merged_at
 		FROM pull_requests
 		WHERE external_id = $1
 		`,
@@ -79,6 +82,7 @@ func (r *PullRequestRepository) GetPullRequestByExternalID(
 		&pr.Title,
 		&pr.Description,
 		&pr.AuthorID,
+		&pr.TeamID,
 		&pr.Status,
 		&pr.NeedMoreReviewers,
 		&pr.CreatedAt,
@@ -104,15 +108,16 @@ func (r *PullRequestRepository) CreatePullRequest(
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		INSERT INTO pull_requests (id, external_id, title, description, author_id, status, need_more_reviewers, created_at, updated_at, merged_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-		RETURNING id, external_id, title, description, author_id, status, need_more_reviewers, created_at, updated_at, merged_at
+		INSERT INTO pull_requests (id, external_id, title, description, author_id, team_id, status, need_more_reviewers, created_at, updated_at, merged_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id, external_id, title, description, author_id, team_id, status, need_more_reviewers, created_at, updated_at, merged_at
 		`,
 		pr.ID,
 		pr.ExternalID,
 		pr.Title,
 		pr.Description,
 		pr.AuthorID,
+		pr.TeamID,
 		pr.Status,
 		pr.NeedMoreReviewers,
 		pr.CreatedAt,
@@ -124,6 +129,7 @@ func (r *PullRequestRepository) CreatePullRequest(
 		&createdPR.Title,
 		&createdPR.Description,
 		&createdPR.AuthorID,
+		&createdPR.TeamID,
 		&createdPR.Status,
 		&createdPR.NeedMoreReviewers,
 		&createdPR.CreatedAt,
@@ -148,12 +154,13 @@ func (r *PullRequestRepository) UpdatePullRequest(
 		ctx,
 		`
 		UPDATE pull_requests 
-		SET title = $1, description = $2, status = $3, need_more_reviewers = $4, updated_at = $5, merged_at = $6
-		WHERE id = $7
-		RETURNING id, external_id, title, description, author_id, status, need_more_reviewers, created_at, updated_at, merged_at
+		SET title = $1, description = $2, team_id = $3, status = $4, need_more_reviewers = $5, updated_at = $6, merged_at = $7
+		WHERE id = $8
+		RETURNING id, external_id, title, description, author_id, team_id, status, need_more_reviewers, created_at, updated_at, merged_at
 		`,
 		pr.Title,
 		pr.Description,
+		pr.TeamID,
 		pr.Status,
 		pr.NeedMoreReviewers,
 		time.Now(),
@@ -165,6 +172,7 @@ func (r *PullRequestRepository) UpdatePullRequest(
 		&updatedPR.Title,
 		&updatedPR.Description,
 		&updatedPR.AuthorID,
+		&updatedPR.TeamID,
 		&updatedPR.Status,
 		&updatedPR.NeedMoreReviewers,
 		&updatedPR.CreatedAt,
@@ -194,7 +202,7 @@ func (r *PullRequestRepository) MergePullRequest(
 		UPDATE pull_requests 
 		SET status = 'MERGED', updated_at = $1, merged_at = $2
 		WHERE id = $3
-		RETURNING id, external_id, title, description, author_id, status, need_more_reviewers, created_at, updated_at, merged_at
+		RETURNING id, external_id, title, description, author_id, team_id, status, need_more_reviewers, created_at, updated_at, merged_at
 		`,
 		time.Now(),
 		time.Now(),
@@ -205,6 +213,7 @@ func (r *PullRequestRepository) MergePullRequest(
 		&mergedPR.Title,
 		&mergedPR.Description,
 		&mergedPR.AuthorID,
+		&mergedPR.TeamID,
 		&mergedPR.Status,
 		&mergedPR.NeedMoreReviewers,
 		&mergedPR.CreatedAt,
@@ -229,7 +238,7 @@ func (r *PullRequestRepository) GetOpenPullRequestsByAuthor(
 	rows, err := r.txMan.Executor(ctx).QueryContext(
 		ctx,
 		`
-		SELECT id, external_id, title, description, author_id, status, need_more_reviewers, created_at, updated_at, merged_at
+		SELECT id, external_id, title, description, author_id, team_id, status, need_more_reviewers, created_at, updated_at, merged_at
 		FROM pull_requests
 		WHERE author_id = $1 AND status = 'OPEN'
 		`,
@@ -252,6 +261,7 @@ func (r *PullRequestRepository) GetOpenPullRequestsByAuthor(
 			&pr.Title,
 			&pr.Description,
 			&pr.AuthorID,
+			&pr.TeamID,
 			&pr.Status,
 			&pr.NeedMoreReviewers,
 			&pr.CreatedAt,
@@ -274,7 +284,7 @@ func (r *PullRequestRepository) GetPullRequestsByStatus(
 	rows, err := r.txMan.Executor(ctx).QueryContext(
 		ctx,
 		`
-		SELECT id, external_id, title, description, author_id, status, need_more_reviewers, created_at, updated_at, merged_at
+		SELECT id, external_id, title, description, author_id, team_id, status, need_more_reviewers, created_at, updated_at, merged_at
 		FROM pull_requests
 		WHERE status = $1
 		`,
@@ -297,6 +307,7 @@ func (r *PullRequestRepository) GetPullRequestsByStatus(
 			&pr.Title,
 			&pr.Description,
 			&pr.AuthorID,
+			&pr.TeamID,
 			&pr.Status,
 			&pr.NeedMoreReviewers,
 			&pr.CreatedAt,
@@ -311,3 +322,208 @@ func (r *PullRequestRepository) GetPullRequestsByStatus(
 
 	return prs, nil
 }
+
+// GetOpenPullRequestsNeedingReviewers возвращает открытые PR команды с флагом need_more_reviewers
+func (r *PullRequestRepository) GetOpenPullRequestsNeedingReviewers(
+	ctx context.Context,
+	teamID uuid.UUID,
+) ([]data.PullRequest, error) {
+	rows, err := r.txMan.Executor(ctx).QueryContext(
+		ctx,
+		`
+		SELECT id, external_id, title, description, author_id, team_id, status, need_more_reviewers, created_at, updated_at, merged_at
+		FROM pull_requests
+		WHERE team_id = $1 AND status = 'OPEN' AND need_more_reviewers = true
+		ORDER BY created_at
+		`,
+		teamID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var prs []data.PullRequest
+	for rows.Next() {
+		var pr data.PullRequest
+		err := rows.Scan(
+			&pr.ID,
+			&pr.ExternalID,
+			&pr.Title,
+			&pr.Description,
+			&pr.AuthorID,
+			&pr.TeamID,
+			&pr.Status,
+			&pr.NeedMoreReviewers,
+			&pr.CreatedAt,
+			&pr.UpdatedAt,
+			&pr.MergedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InternalError)
+		}
+		prs = append(prs, pr)
+	}
+
+	return prs, nil
+}
+
+// CountPullRequestsByTeam возвращает количество всех и открытых PR каждой команды.
+// PR без команды (созданные до ее сохранения в PR) учитываются в основной команде автора
+func (r *PullRequestRepository) CountPullRequestsByTeam(ctx context.Context) ([]data.TeamPullRequestCount, error) {
+	rows, err := r.txMan.Executor(ctx).QueryContext(
+		ctx,
+		`
+		SELECT team_id, COUNT(*), COUNT(*) FILTER (WHERE status = 'OPEN')
+		FROM (
+			SELECT COALESCE(pr.team_id, tm.team_id) AS team_id, pr.status
+			FROM pull_requests pr
+			LEFT JOIN team_members tm ON tm.user_id = pr.author_id AND tm.is_primary = true
+		) prs
+		WHERE team_id IS NOT NULL
+		GROUP BY team_id
+		`,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var counts []data.TeamPullRequestCount
+	for rows.Next() {
+		var count data.TeamPullRequestCount
+		err := rows.Scan(&count.TeamID, &count.TotalPRs, &count.OpenPRs)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InternalError)
+		}
+		counts = append(counts, count)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	return counts, nil
+}
+
+// ListPullRequests возвращает страницу PR, удовлетворяющих фильтру, по возрастанию (created_at, id)
+func (r *PullRequestRepository) ListPullRequests(
+	ctx context.Context,
+	filter data.PullRequestFilter,
+) ([]data.PullRequest, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+
+	addCondition := func(condition string, values ...any) {
+		placeholders := make([]any, 0, len(values))
+		for _, value := range values {
+			args = append(args, value)
+			placeholders = append(placeholders, len(args))
+		}
+
+		conditions = append(conditions, fmt.Sprintf(condition, placeholders...))
+	}
+
+	if filter.Status.Valid {
+		addCondition("pr.status = $%d", filter.Status.V)
+	}
+
+	if filter.AuthorID.Valid {
+		addCondition("pr.author_id = $%d", filter.AuthorID.V)
+	}
+
+	if filter.ReviewerID.Valid {
+		addCondition(
+			`EXISTS (
+				SELECT 1 FROM pr_reviewers r
+				WHERE r.pr_id = pr.id AND r.reviewer_id = $%d AND r.is_current = true
+			)`,
+			filter.ReviewerID.V,
+		)
+	}
+
+	if filter.TeamID.Valid {
+		addCondition("pr.team_id = $%d", filter.TeamID.V)
+	}
+
+	if filter.NeedMoreReviewers.Valid {
+		addCondition("pr.need_more_reviewers = $%d", filter.NeedMoreReviewers.V)
+	}
+
+	if filter.CreatedFrom.Valid {
+		addCondition("pr.created_at >= $%d", filter.CreatedFrom.Time)
+	}
+
+	if filter.CreatedTo.Valid {
+		addCondition("pr.created_at < $%d", filter.CreatedTo.Time)
+	}
+
+	if filter.MergedFrom.Valid {
+		addCondition("pr.merged_at >= $%d", filter.MergedFrom.Time)
+	}
+
+	if filter.MergedTo.Valid {
+		addCondition("pr.merged_at < $%d", filter.MergedTo.Time)
+	}
+
+	if filter.After.Valid {
+		addCondition("(pr.created_at, pr.id) > ($%d, $%d)", filter.After.V.CreatedAt, filter.After.V.ID)
+	}
+
+	query := `
+		SELECT pr.id, pr.external_id, pr.title, pr.description, pr.author_id, pr.team_id, pr.status, pr.need_more_reviewers, pr.created_at, pr.updated_at, pr.merged_at
+		FROM pull_requests pr
+		`
+
+	if len(conditions) > 0 {
+		query += "WHERE " + strings.Join(conditions, " AND ") + "\n"
+	}
+
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf("ORDER BY pr.created_at, pr.id\nLIMIT $%d", len(args))
+
+	rows, err := r.txMan.Executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var prs []data.PullRequest
+	for rows.Next() {
+		var pr data.PullRequest
+		err := rows.Scan(
+			&pr.ID,
+			&pr.ExternalID,
+			&pr.Title,
+			&pr.Description,
+			&pr.AuthorID,
+			&pr.TeamID,
+			&pr.Status,
+			&pr.NeedMoreReviewers,
+			&pr.CreatedAt,
+			&pr.UpdatedAt,
+			&pr.MergedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InternalError)
+		}
+		prs = append(prs, pr)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	return prs, nil
+}
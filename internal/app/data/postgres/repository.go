@@ -9,6 +9,10 @@ type Repository struct {
 	TeamRepository
 	UserRepository
 	TeamMemberRepository
+	TeamFallbackRepository
+	ReviewerRuleRepository
+	UnavailabilityRepository
+	WorkingHoursRepository
 	PullRequestRepository
 	PRReviewerRepository
 	PRReviewerHistoryRepository
@@ -19,6 +23,10 @@ func NewRepository(txMan txman.Manager) *Repository {
 		TeamRepository:              TeamRepository{txMan: txMan},
 		UserRepository:              UserRepository{txMan: txMan},
 		TeamMemberRepository:        TeamMemberRepository{txMan: txMan},
+		TeamFallbackRepository:      TeamFallbackRepository{txMan: txMan},
+		ReviewerRuleRepository:      ReviewerRuleRepository{txMan: txMan},
+		UnavailabilityRepository:    UnavailabilityRepository{txMan: txMan},
+		WorkingHoursRepository:      WorkingHoursRepository{txMan: txMan},
 		PullRequestRepository:       PullRequestRepository{txMan: txMan},
 		PRReviewerRepository:        PRReviewerRepository{txMan: txMan},
 		PRReviewerHistoryRepository: PRReviewerHistoryRepository{txMan: txMan},
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/txman"
+)
+
+type ReviewerRuleRepository struct {
+	txMan txman.Manager
+}
+
+func NewReviewerRuleRepository(txMan txman.Manager) *ReviewerRuleRepository {
+	return &ReviewerRuleRepository{txMan: txMan}
+}
+
+// UpsertReviewerRule создает правило для пары автор-ревьювер или меняет вид существующего
+func (r *ReviewerRuleRepository) UpsertReviewerRule(
+	ctx context.Context,
+	rule data.ReviewerRule,
+) (data.ReviewerRule, error) {
+	var upsertedRule data.ReviewerRule
+
+	err := r.txMan.Executor(ctx).QueryRowContext(
+		ctx,
+		`
+		INSERT INTO reviewer_rules (id, author_id, reviewer_id, kind, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (author_id, reviewer_id) DO UPDATE SET kind = EXCLUDED.kind
+		RETURNING id, author_id, reviewer_id, kind, created_at
+		`,
+		rule.ID,
+		rule.AuthorID,
+		rule.ReviewerID,
+		rule.Kind,
+		rule.CreatedAt,
+	).Scan(
+		&upsertedRule.ID,
+		&upsertedRule.AuthorID,
+		&upsertedRule.ReviewerID,
+		&upsertedRule.Kind,
+		&upsertedRule.CreatedAt,
+	)
+	if err != nil {
+		return data.ReviewerRule{}, errors.Wrap(err, errors.InternalError)
+	}
+
+	return upsertedRule, nil
+}
+
+// DeleteReviewerRule удаляет правило для пары автор-ревьювер
+func (r *ReviewerRuleRepository) DeleteReviewerRule(
+	ctx context.Context,
+	authorID, reviewerID uuid.UUID,
+) error {
+	result, err := r.txMan.Executor(ctx).ExecContext(
+		ctx,
+		`DELETE FROM reviewer_rules WHERE author_id = $1 AND reviewer_id = $2`,
+		authorID, reviewerID,
+	)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if rowsAffected == 0 {
+		return errors.New(api.ErrNotFound)
+	}
+
+	return nil
+}
+
+// GetReviewerRulesByAuthorID возвращает правила, действующие для автора
+func (r *ReviewerRuleRepository) GetReviewerRulesByAuthorID(
+	ctx context.Context,
+	authorID uuid.UUID,
+) ([]data.ReviewerRule, error) {
+	return r.queryReviewerRules(
+		ctx,
+		`
+		SELECT id, author_id, reviewer_id, kind, created_at
+		FROM reviewer_rules
+		WHERE author_id = $1
+		ORDER BY created_at
+		`,
+		authorID,
+	)
+}
+
+// GetAllReviewerRules возвращает все правила
+func (r *ReviewerRuleRepository) GetAllReviewerRules(
+	ctx context.Context,
+) ([]data.ReviewerRule, error) {
+	return r.queryReviewerRules(
+		ctx,
+		`
+		SELECT id, author_id, reviewer_id, kind, created_at
+		FROM reviewer_rules
+		ORDER BY created_at
+		`,
+	)
+}
+
+func (r *ReviewerRuleRepository) queryReviewerRules(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]data.ReviewerRule, error) {
+	rows, err := r.txMan.Executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var rules []data.ReviewerRule
+
+	for rows.Next() {
+		var rule data.ReviewerRule
+
+		err = rows.Scan(
+			&rule.ID,
+			&rule.AuthorID,
+			&rule.ReviewerID,
+			&rule.Kind,
+			&rule.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InternalError)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	return rules, nil
+}
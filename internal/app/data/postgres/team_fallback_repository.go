@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/txman"
+)
+
+type TeamFallbackRepository struct {
+	txMan txman.Manager
+}
+
+func NewTeamFallbackRepository(txMan txman.Manager) TeamFallbackRepository {
+	return TeamFallbackRepository{txMan: txMan}
+}
+
+// GetTeamFallbacks возвращает резервные команды в порядке обращения к ним
+func (r *TeamFallbackRepository) GetTeamFallbacks(
+	ctx context.Context,
+	teamID uuid.UUID,
+) ([]data.TeamFallback, error) {
+	rows, err := r.txMan.Executor(ctx).QueryContext(
+		ctx,
+		`
+		SELECT team_id, fallback_team_id, position, created_at
+		FROM team_fallbacks
+		WHERE team_id = $1
+		ORDER BY position
+		`,
+		teamID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var fallbacks []data.TeamFallback
+
+	for rows.Next() {
+		var fallback data.TeamFallback
+
+		err = rows.Scan(
+			&fallback.TeamID,
+			&fallback.FallbackTeamID,
+			&fallback.Position,
+			&fallback.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InternalError)
+		}
+
+		fallbacks = append(fallbacks, fallback)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	return fallbacks, nil
+}
+
+// ReplaceTeamFallbacks заменяет список резервных команд
+func (r *TeamFallbackRepository) ReplaceTeamFallbacks(
+	ctx context.Context,
+	teamID uuid.UUID,
+	fallbacks []data.TeamFallback,
+) error {
+	_, err := r.txMan.Executor(ctx).ExecContext(
+		ctx,
+		`DELETE FROM team_fallbacks WHERE team_id = $1`,
+		teamID,
+	)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	for _, fallback := range fallbacks {
+		_, err = r.txMan.Executor(ctx).ExecContext(
+			ctx,
+			`
+			INSERT INTO team_fallbacks (team_id, fallback_team_id, position, created_at)
+			VALUES ($1, $2, $3, $4)
+			`,
+			teamID,
+			fallback.FallbackTeamID,
+			fallback.Position,
+			fallback.CreatedAt,
+		)
+		if err != nil {
+			return errors.Wrap(err, errors.InternalError)
+		}
+	}
+
+	return nil
+}
@@ -31,7 +31,7 @@ func (r *TeamMemberRepository) GetTeamMemberByID(
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		SELECT id, team_id, user_id, role, created_at
+		SELECT id, team_id, user_id, role, is_primary, created_at
 		FROM team_members
 		WHERE id = $1
 		`,
@@ -41,6 +41,7 @@ func (r *TeamMemberRepository) GetTeamMemberByID(
 		&teamMember.TeamID,
 		&teamMember.UserID,
 		&teamMember.Role,
+		&teamMember.IsPrimary,
 		&teamMember.CreatedAt,
 	)
 	if err != nil {
@@ -62,7 +63,7 @@ func (r *TeamMemberRepository) GetTeamMemberByTeamAndUser(
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		SELECT id, team_id, user_id, role, created_at
+		SELECT id, team_id, user_id, role, is_primary, created_at
 		FROM team_members
 		WHERE team_id = $1 AND user_id = $2
 		`,
@@ -72,6 +73,7 @@ func (r *TeamMemberRepository) GetTeamMemberByTeamAndUser(
 		&teamMember.TeamID,
 		&teamMember.UserID,
 		&teamMember.Role,
+		&teamMember.IsPrimary,
 		&teamMember.CreatedAt,
 	)
 	if err != nil {
@@ -93,20 +95,22 @@ func (r *TeamMemberRepository) CreateTeamMember(
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		INSERT INTO team_members (id, team_id, user_id, role, created_at)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, team_id, user_id, role, created_at
+		INSERT INTO team_members (id, team_id, user_id, role, is_primary, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, team_id, user_id, role, is_primary, created_at
 		`,
 		teamMember.ID,
 		teamMember.TeamID,
 		teamMember.UserID,
 		teamMember.Role,
+		teamMember.IsPrimary,
 		teamMember.CreatedAt,
 	).Scan(
 		&createdTeamMember.ID,
 		&createdTeamMember.TeamID,
 		&createdTeamMember.UserID,
 		&createdTeamMember.Role,
+		&createdTeamMember.IsPrimary,
 		&createdTeamMember.CreatedAt,
 	)
 	if err != nil {
@@ -129,7 +133,7 @@ func (r *TeamMemberRepository) UpdateTeamMemberRole(
 		UPDATE team_members 
 		SET role = $1
 		WHERE id = $2
-		RETURNING id, team_id, user_id, role, created_at
+		RETURNING id, team_id, user_id, role, is_primary, created_at
 		`,
 		role,
 		teamMemberID,
@@ -138,6 +142,7 @@ func (r *TeamMemberRepository) UpdateTeamMemberRole(
 		&updatedTeamMember.TeamID,
 		&updatedTeamMember.UserID,
 		&updatedTeamMember.Role,
+		&updatedTeamMember.IsPrimary,
 		&updatedTeamMember.CreatedAt,
 	)
 	if err != nil {
@@ -179,7 +184,7 @@ func (r *TeamMemberRepository) GetTeamMembersByTeamID(
 	rows, err := r.txMan.Executor(ctx).QueryContext(
 		ctx,
 		`
-		SELECT id, team_id, user_id, role, created_at
+		SELECT id, team_id, user_id, role, is_primary, created_at
 		FROM team_members
 		WHERE team_id = $1
 		`,
@@ -201,6 +206,7 @@ func (r *TeamMemberRepository) GetTeamMembersByTeamID(
 			&teamMember.TeamID,
 			&teamMember.UserID,
 			&teamMember.Role,
+			&teamMember.IsPrimary,
 			&teamMember.CreatedAt,
 		)
 		if err != nil {
@@ -219,9 +225,10 @@ func (r *TeamMemberRepository) GetTeamMembersByUserID(
 	rows, err := r.txMan.Executor(ctx).QueryContext(
 		ctx,
 		`
-		SELECT id, team_id, user_id, role, created_at
+		SELECT id, team_id, user_id, role, is_primary, created_at
 		FROM team_members
 		WHERE user_id = $1
+		ORDER BY is_primary DESC, created_at
 		`,
 		teamID,
 	)
@@ -241,6 +248,7 @@ func (r *TeamMemberRepository) GetTeamMembersByUserID(
 			&teamMember.TeamID,
 			&teamMember.UserID,
 			&teamMember.Role,
+			&teamMember.IsPrimary,
 			&teamMember.CreatedAt,
 		)
 		if err != nil {
@@ -251,3 +259,39 @@ func (r *TeamMemberRepository) GetTeamMembersByUserID(
 
 	return teamMembers, nil
 }
+
+func (r *TeamMemberRepository) SetPrimaryTeamMember(
+	ctx context.Context,
+	userID, teamMemberID uuid.UUID,
+) error {
+	// Снимаем флаг отдельным запросом, чтобы не нарушить уникальный индекс основной команды.
+	_, err := r.txMan.Executor(ctx).ExecContext(
+		ctx,
+		`UPDATE team_members SET is_primary = false WHERE user_id = $1 AND is_primary`,
+		userID,
+	)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	result, err := r.txMan.Executor(ctx).ExecContext(
+		ctx,
+		`UPDATE team_members SET is_primary = true WHERE id = $1 AND user_id = $2`,
+		teamMemberID,
+		userID,
+	)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if rowsAffected == 0 {
+		return errors.New(api.ErrNotFound)
+	}
+
+	return nil
+}
@@ -33,7 +33,7 @@ func (r *TeamRepository) GetTeamByID(ctx context.Context, ID uuid.UUID) (data.Te
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		SELECT id, external_id, name, description, created_at, updated_at 
+		SELECT id, external_id, name, description, reviewer_strategy, required_reviewers, lead_rule, max_open_reviews, prefer_working_hours, created_at, updated_at
 		FROM teams
 		WHERE id = $1
 		`,
@@ -43,6 +43,11 @@ func (r *TeamRepository) GetTeamByID(ctx context.Context, ID uuid.UUID) (data.Te
 		&team.ExternalID,
 		&team.Name,
 		&team.Description,
+		&team.ReviewerStrategy,
+		&team.RequiredReviewers,
+		&team.LeadRule,
+		&team.MaxOpenReviews,
+		&team.PreferWorkingHours,
 		&team.CreatedAt,
 		&team.UpdatedAt,
 	)
@@ -67,7 +72,7 @@ func (r *TeamRepository) GetTeamByExternalID(
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		SELECT id, external_id, name, description, created_at, updated_at 
+		SELECT id, external_id, name, description, reviewer_strategy, required_reviewers, lead_rule, max_open_reviews, prefer_working_hours, created_at, updated_at
 		FROM teams
 		WHERE external_id = $1
 		`,
@@ -77,6 +82,11 @@ func (r *TeamRepository) GetTeamByExternalID(
 		&team.ExternalID,
 		&team.Name,
 		&team.Description,
+		&team.ReviewerStrategy,
+		&team.RequiredReviewers,
+		&team.LeadRule,
+		&team.MaxOpenReviews,
+		&team.PreferWorkingHours,
 		&team.CreatedAt,
 		&team.UpdatedAt,
 	)
@@ -98,7 +108,7 @@ func (r *TeamRepository) GetTeamByName(ctx context.Context, name string) (data.T
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		SELECT id, external_id, name, description, created_at, updated_at 
+		SELECT id, external_id, name, description, reviewer_strategy, required_reviewers, lead_rule, max_open_reviews, prefer_working_hours, created_at, updated_at
 		FROM teams
 		WHERE name = $1
 		`,
@@ -108,6 +118,11 @@ func (r *TeamRepository) GetTeamByName(ctx context.Context, name string) (data.T
 		&team.ExternalID,
 		&team.Name,
 		&team.Description,
+		&team.ReviewerStrategy,
+		&team.RequiredReviewers,
+		&team.LeadRule,
+		&team.MaxOpenReviews,
+		&team.PreferWorkingHours,
 		&team.CreatedAt,
 		&team.UpdatedAt,
 	)
@@ -128,7 +143,7 @@ func (r *TeamRepository) GetAllTeams(ctx context.Context) ([]data.Team, error) {
 	rows, err := r.txMan.Executor(ctx).QueryContext(
 		ctx,
 		`
-		SELECT id, external_id, name, description, created_at, updated_at 
+		SELECT id, external_id, name, description, reviewer_strategy, required_reviewers, lead_rule, max_open_reviews, prefer_working_hours, created_at, updated_at
 		FROM teams
 		`,
 	)
@@ -159,6 +174,11 @@ func (r *TeamRepository) GetAllTeams(ctx context.Context) ([]data.Team, error) {
 			&team.ExternalID,
 			&team.Name,
 			&team.Description,
+			&team.ReviewerStrategy,
+			&team.RequiredReviewers,
+			&team.LeadRule,
+			&team.MaxOpenReviews,
+			&team.PreferWorkingHours,
 			&team.CreatedAt,
 			&team.UpdatedAt,
 		)
@@ -179,14 +199,19 @@ func (r *TeamRepository) CreateTeam(ctx context.Context, team data.Team) (data.T
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		INSERT INTO teams (id, external_id, name, description, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
-		RETURNING id, external_id, name, description, created_at, updated_at
+		INSERT INTO teams (id, external_id, name, description, reviewer_strategy, required_reviewers, lead_rule, max_open_reviews, prefer_working_hours, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id, external_id, name, description, reviewer_strategy, required_reviewers, lead_rule, max_open_reviews, prefer_working_hours, created_at, updated_at
 		`,
 		team.ID,
 		team.ExternalID,
 		team.Name,
 		team.Description,
+		team.ReviewerStrategy,
+		team.RequiredReviewers,
+		team.LeadRule,
+		team.MaxOpenReviews,
+		team.PreferWorkingHours,
 		team.CreatedAt,
 		team.UpdatedAt,
 	).Scan(
@@ -194,6 +219,11 @@ func (r *TeamRepository) CreateTeam(ctx context.Context, team data.Team) (data.T
 		&createdTeam.ExternalID,
 		&createdTeam.Name,
 		&createdTeam.Description,
+		&createdTeam.ReviewerStrategy,
+		&createdTeam.RequiredReviewers,
+		&createdTeam.LeadRule,
+		&createdTeam.MaxOpenReviews,
+		&createdTeam.PreferWorkingHours,
 		&createdTeam.CreatedAt,
 		&createdTeam.UpdatedAt,
 	)
@@ -217,12 +247,17 @@ func (r *TeamRepository) UpdateTeam(ctx context.Context, team data.Team) (data.T
 		ctx,
 		`
 		UPDATE teams 
-		SET name = $1, description = $2, updated_at = $3
-		WHERE id = $4
-		RETURNING id, external_id, name, description, created_at, updated_at
+		SET name = $1, description = $2, reviewer_strategy = $3, required_reviewers = $4, lead_rule = $5, max_open_reviews = $6, prefer_working_hours = $7, updated_at = $8
+		WHERE id = $9
+		RETURNING id, external_id, name, description, reviewer_strategy, required_reviewers, lead_rule, max_open_reviews, prefer_working_hours, created_at, updated_at
 		`,
 		team.Name,
 		team.Description,
+		team.ReviewerStrategy,
+		team.RequiredReviewers,
+		team.LeadRule,
+		team.MaxOpenReviews,
+		team.PreferWorkingHours,
 		time.Now(),
 		team.ID,
 	).Scan(
@@ -230,6 +265,11 @@ func (r *TeamRepository) UpdateTeam(ctx context.Context, team data.Team) (data.T
 		&updatedTeam.ExternalID,
 		&updatedTeam.Name,
 		&updatedTeam.Description,
+		&updatedTeam.ReviewerStrategy,
+		&updatedTeam.RequiredReviewers,
+		&updatedTeam.LeadRule,
+		&updatedTeam.MaxOpenReviews,
+		&updatedTeam.PreferWorkingHours,
 		&updatedTeam.CreatedAt,
 		&updatedTeam.UpdatedAt,
 	)
@@ -237,8 +277,34 @@ func (r *TeamRepository) UpdateTeam(ctx context.Context, team data.Team) (data.T
 		if goerrors.Is(err, sql.ErrNoRows) {
 			return data.Team{}, errors.New(api.ErrNotFound)
 		}
+		if db.IsUniqueConstraintViolationError(err) {
+			return data.Team{}, errors.New(api.ErrTeamExists)
+		}
 		return data.Team{}, errors.Wrap(err, errors.InternalError)
 	}
 
 	return updatedTeam, nil
 }
+
+// DeleteTeam удаляет команду вместе с ее составом
+func (r *TeamRepository) DeleteTeam(ctx context.Context, ID uuid.UUID) error {
+	result, err := r.txMan.Executor(ctx).ExecContext(
+		ctx,
+		`DELETE FROM teams WHERE id = $1`,
+		ID,
+	)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if rowsAffected == 0 {
+		return errors.New(api.ErrNotFound)
+	}
+
+	return nil
+}
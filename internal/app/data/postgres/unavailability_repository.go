@@ -0,0 +1,352 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	goerrors "errors"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/txman"
+)
+
+type UnavailabilityRepository struct {
+	txMan txman.Manager
+}
+
+// GetUnavailabilityPeriodByID получает период недоступности по ID
+func (r *UnavailabilityRepository) GetUnavailabilityPeriodByID(
+	ctx context.Context,
+	ID uuid.UUID,
+) (data.UnavailabilityPeriod, error) {
+	period, err := scanUnavailabilityPeriod(r.txMan.Executor(ctx).QueryRowContext(
+		ctx,
+		`
+		SELECT id, user_id, starts_at, ends_at, reason, handed_off_at, external_uid, created_at, updated_at
+		FROM user_unavailability
+		WHERE id = $1
+		`,
+		ID,
+	))
+	if err != nil {
+		if goerrors.Is(err, sql.ErrNoRows) {
+			return data.UnavailabilityPeriod{}, errors.New(api.ErrNotFound)
+		}
+
+		return data.UnavailabilityPeriod{}, errors.Wrap(err, errors.InternalError)
+	}
+
+	return period, nil
+}
+
+// CreateUnavailabilityPeriod создает новый период недоступности
+func (r *UnavailabilityRepository) CreateUnavailabilityPeriod(
+	ctx context.Context,
+	period data.UnavailabilityPeriod,
+) (data.UnavailabilityPeriod, error) {
+	createdPeriod, err := scanUnavailabilityPeriod(r.txMan.Executor(ctx).QueryRowContext(
+		ctx,
+		`
+		INSERT INTO user_unavailability (id, user_id, starts_at, ends_at, reason, handed_off_at, external_uid, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, user_id, starts_at, ends_at, reason, handed_off_at, external_uid, created_at, updated_at
+		`,
+		period.ID,
+		period.UserID,
+		period.StartsAt,
+		period.EndsAt,
+		period.Reason,
+		period.HandedOffAt,
+		period.ExternalUID,
+		period.CreatedAt,
+		period.UpdatedAt,
+	))
+	if err != nil {
+		return data.UnavailabilityPeriod{}, errors.Wrap(err, errors.InternalError)
+	}
+
+	return createdPeriod, nil
+}
+
+// UpdateUnavailabilityPeriod обновляет период недоступности
+func (r *UnavailabilityRepository) UpdateUnavailabilityPeriod(
+	ctx context.Context,
+	period data.UnavailabilityPeriod,
+) (data.UnavailabilityPeriod, error) {
+	updatedPeriod, err := scanUnavailabilityPeriod(r.txMan.Executor(ctx).QueryRowContext(
+		ctx,
+		`
+		UPDATE user_unavailability
+		SET starts_at = $1, ends_at = $2, reason = $3, handed_off_at = $4, updated_at = $5
+		WHERE id = $6
+		RETURNING id, user_id, starts_at, ends_at, reason, handed_off_at, external_uid, created_at, updated_at
+		`,
+		period.StartsAt,
+		period.EndsAt,
+		period.Reason,
+		period.HandedOffAt,
+		time.Now(),
+		period.ID,
+	))
+	if err != nil {
+		if goerrors.Is(err, sql.ErrNoRows) {
+			return data.UnavailabilityPeriod{}, errors.New(api.ErrNotFound)
+		}
+
+		return data.UnavailabilityPeriod{}, errors.Wrap(err, errors.InternalError)
+	}
+
+	return updatedPeriod, nil
+}
+
+// DeleteUnavailabilityPeriod удаляет период недоступности
+func (r *UnavailabilityRepository) DeleteUnavailabilityPeriod(ctx context.Context, ID uuid.UUID) error {
+	result, err := r.txMan.Executor(ctx).ExecContext(
+		ctx,
+		`DELETE FROM user_unavailability WHERE id = $1`,
+		ID,
+	)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if rowsAffected == 0 {
+		return errors.New(api.ErrNotFound)
+	}
+
+	return nil
+}
+
+// ListUnavailabilityPeriods возвращает периоды, удовлетворяющие фильтру, по возрастанию начала
+func (r *UnavailabilityRepository) ListUnavailabilityPeriods(
+	ctx context.Context,
+	filter data.UnavailabilityFilter,
+) ([]data.UnavailabilityPeriod, error) {
+	return r.queryUnavailabilityPeriods(
+		ctx,
+		`
+		SELECT id, user_id, starts_at, ends_at, reason, handed_off_at, external_uid, created_at, updated_at
+		FROM user_unavailability
+		WHERE ($1::uuid IS NULL OR user_id = $1)
+			AND ($2::timestamptz IS NULL OR ends_at > $2)
+		ORDER BY starts_at, id
+		`,
+		filter.UserID,
+		filter.EndsAfter,
+	)
+}
+
+// GetUnavailableUserIDs возвращает тех из переданных пользователей, кто недоступен в момент at
+func (r *UnavailabilityRepository) GetUnavailableUserIDs(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+	at time.Time,
+) ([]uuid.UUID, error) {
+	ids := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		ids = append(ids, id.String())
+	}
+
+	rows, err := r.txMan.Executor(ctx).QueryContext(
+		ctx,
+		`
+		SELECT DISTINCT user_id
+		FROM user_unavailability
+		WHERE user_id = ANY($1::uuid[])
+			AND starts_at <= $2
+			AND ends_at > $2
+		`,
+		ids,
+		at,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var unavailable []uuid.UUID
+
+	for rows.Next() {
+		var userID uuid.UUID
+
+		err = rows.Scan(&userID)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InternalError)
+		}
+
+		unavailable = append(unavailable, userID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	return unavailable, nil
+}
+
+// GetPendingHandOffPeriods возвращает идущие в момент at периоды, по которым ревью еще не передавались
+func (r *UnavailabilityRepository) GetPendingHandOffPeriods(
+	ctx context.Context,
+	at time.Time,
+) ([]data.UnavailabilityPeriod, error) {
+	return r.queryUnavailabilityPeriods(
+		ctx,
+		`
+		SELECT id, user_id, starts_at, ends_at, reason, handed_off_at, external_uid, created_at, updated_at
+		FROM user_unavailability
+		WHERE handed_off_at IS NULL
+			AND starts_at <= $1
+			AND ends_at > $1
+		ORDER BY starts_at, id
+		`,
+		at,
+	)
+}
+
+// UpsertUnavailabilityPeriodByUID создает период, импортированный из события календаря, или
+// обновляет границы и причину ранее импортированного периода пользователя с тем же UID.
+// При переносе начала периода отметка о передаче ревью сбрасывается.
+// created означает, что период был создан.
+func (r *UnavailabilityRepository) UpsertUnavailabilityPeriodByUID(
+	ctx context.Context,
+	period data.UnavailabilityPeriod,
+) (upserted data.UnavailabilityPeriod, created bool, err error) {
+	err = r.txMan.Executor(ctx).QueryRowContext(
+		ctx,
+		`
+		INSERT INTO user_unavailability AS u
+			(id, user_id, starts_at, ends_at, reason, handed_off_at, external_uid, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (external_uid, user_id) WHERE external_uid IS NOT NULL DO UPDATE
+		SET starts_at = EXCLUDED.starts_at,
+			ends_at = EXCLUDED.ends_at,
+			reason = EXCLUDED.reason,
+			handed_off_at = CASE WHEN u.starts_at = EXCLUDED.starts_at THEN u.handed_off_at END,
+			updated_at = EXCLUDED.updated_at
+		RETURNING id, user_id, starts_at, ends_at, reason, handed_off_at, external_uid, created_at, updated_at,
+			xmax = 0
+		`,
+		period.ID,
+		period.UserID,
+		period.StartsAt,
+		period.EndsAt,
+		period.Reason,
+		period.HandedOffAt,
+		period.ExternalUID,
+		period.CreatedAt,
+		period.UpdatedAt,
+	).Scan(
+		&upserted.ID,
+		&upserted.UserID,
+		&upserted.StartsAt,
+		&upserted.EndsAt,
+		&upserted.Reason,
+		&upserted.HandedOffAt,
+		&upserted.ExternalUID,
+		&upserted.CreatedAt,
+		&upserted.UpdatedAt,
+		&created,
+	)
+	if err != nil {
+		return data.UnavailabilityPeriod{}, false, errors.Wrap(err, errors.InternalError)
+	}
+
+	return upserted, created, nil
+}
+
+// DeleteUnavailabilityPeriodsByUID удаляет периоды, импортированные из события календаря,
+// кроме периодов пользователей из keepUserIDs. Возвращает количество удаленных периодов.
+func (r *UnavailabilityRepository) DeleteUnavailabilityPeriodsByUID(
+	ctx context.Context,
+	externalUID string,
+	keepUserIDs []uuid.UUID,
+) (int64, error) {
+	ids := make([]string, 0, len(keepUserIDs))
+	for _, id := range keepUserIDs {
+		ids = append(ids, id.String())
+	}
+
+	result, err := r.txMan.Executor(ctx).ExecContext(
+		ctx,
+		`
+		DELETE FROM user_unavailability
+		WHERE external_uid = $1 AND NOT (user_id = ANY($2::uuid[]))
+		`,
+		externalUID,
+		ids,
+	)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.InternalError)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.InternalError)
+	}
+
+	return rowsAffected, nil
+}
+
+func (r *UnavailabilityRepository) queryUnavailabilityPeriods(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]data.UnavailabilityPeriod, error) {
+	rows, err := r.txMan.Executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var periods []data.UnavailabilityPeriod
+
+	for rows.Next() {
+		period, err := scanUnavailabilityPeriod(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InternalError)
+		}
+
+		periods = append(periods, period)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	return periods, nil
+}
+
+// scanUnavailabilityPeriod читает период недоступности из строки результата.
+func scanUnavailabilityPeriod(row interface{ Scan(dest ...any) error }) (data.UnavailabilityPeriod, error) {
+	var period data.UnavailabilityPeriod
+
+	err := row.Scan(
+		&period.ID,
+		&period.UserID,
+		&period.StartsAt,
+		&period.EndsAt,
+		&period.Reason,
+		&period.HandedOffAt,
+		&period.ExternalUID,
+		&period.CreatedAt,
+		&period.UpdatedAt,
+	)
+
+	return period, err
+}
@@ -25,7 +25,7 @@ func (r *UserRepository) GetUserByID(ctx context.Context, ID uuid.UUID) (data.Us
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		SELECT id, external_id, username, email, is_active, created_at, updated_at 
+		SELECT id, external_id, username, email, is_active, max_open_reviews, timezone, created_at, updated_at 
 		FROM users
 		WHERE id = $1
 		`,
@@ -36,6 +36,8 @@ func (r *UserRepository) GetUserByID(ctx context.Context, ID uuid.UUID) (data.Us
 		&user.Username,
 		&user.Email,
 		&user.IsActive,
+		&user.MaxOpenReviews,
+		&user.Timezone,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -58,7 +60,7 @@ func (r *UserRepository) GetUserByExternalID(
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		SELECT id, external_id, username, email, is_active, created_at, updated_at 
+		SELECT id, external_id, username, email, is_active, max_open_reviews, timezone, created_at, updated_at 
 		FROM users
 		WHERE external_id = $1
 		`,
@@ -69,6 +71,8 @@ func (r *UserRepository) GetUserByExternalID(
 		&user.Username,
 		&user.Email,
 		&user.IsActive,
+		&user.MaxOpenReviews,
+		&user.Timezone,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -88,7 +92,7 @@ func (r *UserRepository) GetUserByName(ctx context.Context, userName string) (da
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		SELECT id, external_id, username, email, is_active, created_at, updated_at 
+		SELECT id, external_id, username, email, is_active, max_open_reviews, timezone, created_at, updated_at 
 		FROM users
 		WHERE username = $1
 		`,
@@ -99,6 +103,8 @@ func (r *UserRepository) GetUserByName(ctx context.Context, userName string) (da
 		&user.Username,
 		&user.Email,
 		&user.IsActive,
+		&user.MaxOpenReviews,
+		&user.Timezone,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -118,7 +124,7 @@ func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (data
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		SELECT id, external_id, username, email, is_active, created_at, updated_at 
+		SELECT id, external_id, username, email, is_active, max_open_reviews, timezone, created_at, updated_at 
 		FROM users
 		WHERE email = $1
 		`,
@@ -129,6 +135,8 @@ func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (data
 		&user.Username,
 		&user.Email,
 		&user.IsActive,
+		&user.MaxOpenReviews,
+		&user.Timezone,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -148,15 +156,17 @@ func (r *UserRepository) CreateUser(ctx context.Context, user data.User) (data.U
 	err := r.txMan.Executor(ctx).QueryRowContext(
 		ctx,
 		`
-		INSERT INTO users (id, external_id, username, email, is_active, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, external_id, username, email, is_active, created_at, updated_at
+		INSERT INTO users (id, external_id, username, email, is_active, max_open_reviews, timezone, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, external_id, username, email, is_active, max_open_reviews, timezone, created_at, updated_at
 		`,
 		user.ID,
 		user.ExternalID,
 		user.Username,
 		user.Email,
 		user.IsActive,
+		user.MaxOpenReviews,
+		user.Timezone,
 		user.CreatedAt,
 		user.UpdatedAt,
 	).Scan(
@@ -165,6 +175,8 @@ func (r *UserRepository) CreateUser(ctx context.Context, user data.User) (data.U
 		&createdUser.Username,
 		&createdUser.Email,
 		&createdUser.IsActive,
+		&createdUser.MaxOpenReviews,
+		&createdUser.Timezone,
 		&createdUser.CreatedAt,
 		&createdUser.UpdatedAt,
 	)
@@ -182,13 +194,17 @@ func (r *UserRepository) UpdateUser(ctx context.Context, user data.User) (data.U
 		ctx,
 		`
 		UPDATE users 
-		SET username = $1, email = $2, is_active = $3, updated_at = $4
-		WHERE id = $5
-		RETURNING id, external_id, username, email, is_active, created_at, updated_at
+		SET external_id = $1, username = $2, email = $3, is_active = $4, max_open_reviews = $5, timezone = $6,
+			updated_at = $7
+		WHERE id = $8
+		RETURNING id, external_id, username, email, is_active, max_open_reviews, timezone, created_at, updated_at
 		`,
+		user.ExternalID,
 		user.Username,
 		user.Email,
 		user.IsActive,
+		user.MaxOpenReviews,
+		user.Timezone,
 		time.Now(),
 		user.ID,
 	).Scan(
@@ -197,6 +213,8 @@ func (r *UserRepository) UpdateUser(ctx context.Context, user data.User) (data.U
 		&updatedUser.Username,
 		&updatedUser.Email,
 		&updatedUser.IsActive,
+		&updatedUser.MaxOpenReviews,
+		&updatedUser.Timezone,
 		&updatedUser.CreatedAt,
 		&updatedUser.UpdatedAt,
 	)
@@ -223,7 +241,7 @@ func (r *UserRepository) SetUserActive(
 		UPDATE users 
 		SET is_active = $1, updated_at = $2
 		WHERE id = $3
-		RETURNING id, external_id, username, email, is_active, created_at, updated_at
+		RETURNING id, external_id, username, email, is_active, max_open_reviews, timezone, created_at, updated_at
 		`,
 		isActive,
 		time.Now(),
@@ -234,6 +252,8 @@ func (r *UserRepository) SetUserActive(
 		&user.Username,
 		&user.Email,
 		&user.IsActive,
+		&user.MaxOpenReviews,
+		&user.Timezone,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -246,3 +266,69 @@ func (r *UserRepository) SetUserActive(
 
 	return user, nil
 }
+
+// ListUsers возвращает страницу пользователей, удовлетворяющих фильтру, по возрастанию (created_at, id)
+func (r *UserRepository) ListUsers(ctx context.Context, filter data.UserFilter) ([]data.User, error) {
+	var (
+		afterCreatedAt sql.NullTime
+		afterID        uuid.NullUUID
+	)
+
+	if filter.After.Valid {
+		afterCreatedAt = sql.NullTime{Time: filter.After.V.CreatedAt, Valid: true}
+		afterID = uuid.NullUUID{UUID: filter.After.V.ID, Valid: true}
+	}
+
+	rows, err := r.txMan.Executor(ctx).QueryContext(
+		ctx,
+		`
+		SELECT u.id, u.external_id, u.username, u.email, u.is_active, u.max_open_reviews, u.timezone, u.created_at, u.updated_at
+		FROM users u
+		WHERE ($1::boolean IS NULL OR u.is_active = $1)
+			AND ($2::uuid IS NULL OR EXISTS (
+				SELECT 1 FROM team_members tm WHERE tm.user_id = u.id AND tm.team_id = $2
+			))
+			AND ($3::timestamp IS NULL OR (u.created_at, u.id) > ($3, $4))
+		ORDER BY u.created_at, u.id
+		LIMIT $5
+		`,
+		filter.IsActive,
+		filter.TeamID,
+		afterCreatedAt,
+		afterID,
+		filter.Limit,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var users []data.User
+	for rows.Next() {
+		var user data.User
+		err := rows.Scan(
+			&user.ID,
+			&user.ExternalID,
+			&user.Username,
+			&user.Email,
+			&user.IsActive,
+			&user.MaxOpenReviews,
+			&user.Timezone,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InternalError)
+		}
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	return users, nil
+}
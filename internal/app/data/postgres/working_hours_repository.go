@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/txman"
+)
+
+type WorkingHoursRepository struct {
+	txMan txman.Manager
+}
+
+// GetWorkingHours возвращает рабочие интервалы пользователей по возрастанию дня недели и начала интервала
+func (r *WorkingHoursRepository) GetWorkingHours(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+) ([]data.WorkingHours, error) {
+	ids := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		ids = append(ids, id.String())
+	}
+
+	rows, err := r.txMan.Executor(ctx).QueryContext(
+		ctx,
+		`
+		SELECT user_id, weekday,
+			EXTRACT(EPOCH FROM starts_at)::int / 60,
+			EXTRACT(EPOCH FROM ends_at)::int / 60
+		FROM user_working_hours
+		WHERE user_id = ANY($1::uuid[])
+		ORDER BY user_id, weekday, starts_at
+		`,
+		ids,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var hours []data.WorkingHours
+
+	for rows.Next() {
+		var interval data.WorkingHours
+
+		err = rows.Scan(&interval.UserID, &interval.Weekday, &interval.StartMinute, &interval.EndMinute)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InternalError)
+		}
+
+		hours = append(hours, interval)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError)
+	}
+
+	return hours, nil
+}
+
+// SetUserWorkingHours заменяет рабочие интервалы пользователя (вызывается в транзакции)
+func (r *WorkingHoursRepository) SetUserWorkingHours(
+	ctx context.Context,
+	userID uuid.UUID,
+	hours []data.WorkingHours,
+) error {
+	_, err := r.txMan.Executor(ctx).ExecContext(
+		ctx,
+		`DELETE FROM user_working_hours WHERE user_id = $1`,
+		userID,
+	)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	for _, interval := range hours {
+		_, err = r.txMan.Executor(ctx).ExecContext(
+			ctx,
+			`
+			INSERT INTO user_working_hours (user_id, weekday, starts_at, ends_at)
+			VALUES ($1, $2, $3::time, $4::time)
+			`,
+			userID,
+			interval.Weekday,
+			minuteOfDay(interval.StartMinute),
+			minuteOfDay(interval.EndMinute),
+		)
+		if err != nil {
+			return errors.Wrap(err, errors.InternalError)
+		}
+	}
+
+	return nil
+}
+
+// minuteOfDay форматирует минуты от начала дня как время HH:MM (1440 - 24:00).
+func minuteOfDay(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -19,6 +20,8 @@ type TeamRepository interface {
 	CreateTeam(ctx context.Context, team Team) (Team, error)
 	// UpdateTeam обновляет данные команды.
 	UpdateTeam(ctx context.Context, team Team) (Team, error)
+	// DeleteTeam удаляет команду вместе с ее составом.
+	DeleteTeam(ctx context.Context, ID uuid.UUID) error
 }
 
 type UserRepository interface {
@@ -34,6 +37,8 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, user User) (User, error)
 	// UpdateUser обновляет данные пользователя.
 	UpdateUser(ctx context.Context, user User) (User, error)
+	// ListUsers возвращает страницу пользователей, удовлетворяющих фильтру.
+	ListUsers(ctx context.Context, filter UserFilter) ([]User, error)
 	// SetUserActive устанавливает флаг активности пользователя.
 	SetUserActive(ctx context.Context, userID uuid.UUID, isActive bool) (User, error)
 }
@@ -55,8 +60,77 @@ type TeamMemberRepository interface {
 	DeleteTeamMember(ctx context.Context, teamMemberID uuid.UUID) error
 	// GetTeamMembersByTeamID возвращает всех участников команды.
 	GetTeamMembersByTeamID(ctx context.Context, teamID uuid.UUID) ([]TeamMember, error)
-	// GetTeamMembersByUserID возвращает все участия в командах для пользователя.
+	// GetTeamMembersByUserID возвращает все участия в командах для пользователя
+	// (основная команда идет первой).
 	GetTeamMembersByUserID(ctx context.Context, userID uuid.UUID) ([]TeamMember, error)
+	// SetPrimaryTeamMember делает участие в команде основным для пользователя.
+	SetPrimaryTeamMember(ctx context.Context, userID, teamMemberID uuid.UUID) error
+}
+
+type TeamFallbackRepository interface {
+	// GetTeamFallbacks возвращает резервные команды в порядке обращения к ним.
+	GetTeamFallbacks(ctx context.Context, teamID uuid.UUID) ([]TeamFallback, error)
+	// ReplaceTeamFallbacks заменяет список резервных команд.
+	ReplaceTeamFallbacks(ctx context.Context, teamID uuid.UUID, fallbacks []TeamFallback) error
+}
+
+type ReviewerRuleRepository interface {
+	// UpsertReviewerRule создает правило для пары автор-ревьювер или меняет вид существующего.
+	UpsertReviewerRule(ctx context.Context, rule ReviewerRule) (ReviewerRule, error)
+	// DeleteReviewerRule удаляет правило для пары автор-ревьювер.
+	DeleteReviewerRule(ctx context.Context, authorID, reviewerID uuid.UUID) error
+	// GetReviewerRulesByAuthorID возвращает правила, действующие для автора.
+	GetReviewerRulesByAuthorID(ctx context.Context, authorID uuid.UUID) ([]ReviewerRule, error)
+	// GetAllReviewerRules возвращает все правила.
+	GetAllReviewerRules(ctx context.Context) ([]ReviewerRule, error)
+}
+
+type WorkingHoursRepository interface {
+	// GetWorkingHours возвращает рабочие интервалы переданных пользователей
+	// по возрастанию дня недели и начала интервала.
+	GetWorkingHours(ctx context.Context, userIDs []uuid.UUID) ([]WorkingHours, error)
+	// SetUserWorkingHours заменяет рабочие интервалы пользователя.
+	SetUserWorkingHours(ctx context.Context, userID uuid.UUID, hours []WorkingHours) error
+}
+
+type UnavailabilityRepository interface {
+	// GetUnavailabilityPeriodByID получает период недоступности по ID.
+	GetUnavailabilityPeriodByID(ctx context.Context, ID uuid.UUID) (UnavailabilityPeriod, error)
+	// CreateUnavailabilityPeriod создает новый период недоступности.
+	CreateUnavailabilityPeriod(
+		ctx context.Context,
+		period UnavailabilityPeriod,
+	) (UnavailabilityPeriod, error)
+	// UpdateUnavailabilityPeriod обновляет период недоступности.
+	UpdateUnavailabilityPeriod(
+		ctx context.Context,
+		period UnavailabilityPeriod,
+	) (UnavailabilityPeriod, error)
+	// DeleteUnavailabilityPeriod удаляет период недоступности.
+	DeleteUnavailabilityPeriod(ctx context.Context, ID uuid.UUID) error
+	// ListUnavailabilityPeriods возвращает периоды, удовлетворяющие фильтру, по возрастанию начала.
+	ListUnavailabilityPeriods(
+		ctx context.Context,
+		filter UnavailabilityFilter,
+	) ([]UnavailabilityPeriod, error)
+	// UpsertUnavailabilityPeriodByUID создает или обновляет период пользователя,
+	// импортированный из события календаря с тем же UID. created означает, что период был создан.
+	UpsertUnavailabilityPeriodByUID(
+		ctx context.Context,
+		period UnavailabilityPeriod,
+	) (upserted UnavailabilityPeriod, created bool, err error)
+	// DeleteUnavailabilityPeriodsByUID удаляет периоды, импортированные из события календаря,
+	// кроме периодов пользователей из keepUserIDs.
+	DeleteUnavailabilityPeriodsByUID(
+		ctx context.Context,
+		externalUID string,
+		keepUserIDs []uuid.UUID,
+	) (int64, error)
+	// GetUnavailableUserIDs возвращает тех из переданных пользователей, кто недоступен в момент at.
+	GetUnavailableUserIDs(ctx context.Context, userIDs []uuid.UUID, at time.Time) ([]uuid.UUID, error)
+	// GetPendingHandOffPeriods возвращает начавшиеся к моменту at и еще не закончившиеся периоды,
+	// после начала которых открытые ревью пользователя еще не передавались.
+	GetPendingHandOffPeriods(ctx context.Context, at time.Time) ([]UnavailabilityPeriod, error)
 }
 
 type PullRequestRepository interface {
@@ -72,8 +146,17 @@ type PullRequestRepository interface {
 	MergePullRequest(ctx context.Context, prID uuid.UUID) (PullRequest, error)
 	// GetOpenPullRequestsByAuthor возвращает открытые PR автора.
 	GetOpenPullRequestsByAuthor(ctx context.Context, authorID uuid.UUID) ([]PullRequest, error)
+	// ListPullRequests возвращает страницу PR, удовлетворяющих фильтру, по возрастанию (created_at, id).
+	ListPullRequests(ctx context.Context, filter PullRequestFilter) ([]PullRequest, error)
 	// GetPullRequestsByStatus возвращает PR автора.
 	GetPullRequestsByStatus(ctx context.Context, status string) ([]PullRequest, error)
+	// GetOpenPullRequestsNeedingReviewers возвращает открытые PR команды с нехваткой ревьюверов.
+	GetOpenPullRequestsNeedingReviewers(
+		ctx context.Context,
+		teamID uuid.UUID,
+	) ([]PullRequest, error)
+	// CountPullRequestsByTeam возвращает количество всех и открытых PR каждой команды.
+	CountPullRequestsByTeam(ctx context.Context) ([]TeamPullRequestCount, error)
 }
 
 type PRReviewerRepository interface {
@@ -85,8 +168,15 @@ type PRReviewerRepository interface {
 	GetCurrentReviewers(ctx context.Context, prID uuid.UUID) ([]PRReviewer, error)
 	// GetUserAssignedPRs возвращает PR, на которые назначен пользователь как ревьювер.
 	GetUserAssignedPRs(ctx context.Context, userID uuid.UUID) ([]PRReviewer, error)
+	// GetReviewAssignments возвращает страницу текущих назначений ревьювера с данными PR и автора.
+	GetReviewAssignments(ctx context.Context, filter ReviewAssignmentFilter) ([]ReviewAssignment, error)
+	// CountTeamAssignments возвращает количество текущих назначений, сделанных из команды.
+	CountTeamAssignments(ctx context.Context, teamID uuid.UUID) (int64, error)
 	// UpdatePRReviewer обновляет данные назначения ревьювера
 	UpdatePRReviewer(ctx context.Context, reviewer PRReviewer) (PRReviewer, error)
+	// GetReviewersLoad возвращает одним запросом количество текущих назначений на открытые PR
+	// и время последнего назначения для каждого из переданных пользователей.
+	GetReviewersLoad(ctx context.Context, userIDs []uuid.UUID) ([]ReviewerLoad, error)
 }
 
 type PRReviewerHistoryRepository interface {
@@ -97,7 +187,7 @@ type PRReviewerHistoryRepository interface {
 		ctx context.Context,
 		history PRReviewerHistory,
 	) (PRReviewerHistory, error)
-	// GetPRReviewerHistory возвращает историю изменений для PR.
+	// GetPRReviewerHistory возвращает историю изменений для PR в хронологическом порядке.
 	GetPRReviewerHistory(ctx context.Context, prID uuid.UUID) ([]PRReviewerHistory, error)
 }
 
@@ -106,6 +196,10 @@ type Repository interface {
 	TeamRepository
 	UserRepository
 	TeamMemberRepository
+	TeamFallbackRepository
+	ReviewerRuleRepository
+	UnavailabilityRepository
+	WorkingHoursRepository
 	PullRequestRepository
 	PRReviewerRepository
 	PRReviewerHistoryRepository
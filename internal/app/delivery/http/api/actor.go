@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// HeaderActorID - заголовок с внешним ID пользователя, выполняющего запрос.
+	// Выставляется аутентифицирующим прокси; вместо него можно передать токен в Authorization.
+	HeaderActorID = "X-Actor-ID"
+	// HeaderChangeReason - заголовок с причиной изменения в свободной форме (URL-encoded).
+	HeaderChangeReason = "X-Change-Reason"
+)
+
+type ctxActor struct{}
+
+type requestActor struct {
+	userID string
+	reason string
+}
+
+// WithActor задает инициатора и причину изменения для запросов клиента, выполняемых с этим контекстом.
+func WithActor(ctx context.Context, userID, reason string) context.Context {
+	return context.WithValue(ctx, ctxActor{}, requestActor{userID: userID, reason: reason})
+}
+
+// actorTransport добавляет к запросам заголовки инициатора из контекста запроса.
+type actorTransport struct {
+	base http.RoundTripper
+}
+
+func (t actorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	a, ok := req.Context().Value(ctxActor{}).(requestActor)
+	if ok {
+		req = req.Clone(req.Context())
+
+		req.Header.Set(HeaderActorID, a.userID)
+
+		if a.reason != "" {
+			req.Header.Set(HeaderChangeReason, url.PathEscape(a.reason))
+		}
+	}
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return base.RoundTrip(req)
+}
@@ -5,6 +5,7 @@ import (
 
 	"pr-reviewer-assign-service/internal/app/delivery/http/api/health"
 	"pr-reviewer-assign-service/internal/app/delivery/http/api/pullrequests"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/rules"
 	"pr-reviewer-assign-service/internal/app/delivery/http/api/statistics"
 	"pr-reviewer-assign-service/internal/app/delivery/http/api/teams"
 	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
@@ -13,15 +14,21 @@ import (
 type Client struct {
 	healthClient       health.Client
 	pullRequestsClient pullrequests.Client
+	rulesClient        rules.Client
 	statisticsClient   statistics.Client
 	teamsClient        teams.Client
 	usersClient        users.Client
 }
 
 func NewClient(c *http.Client, baseUrl string) Client {
+	withActor := *c
+	withActor.Transport = actorTransport{base: c.Transport}
+	c = &withActor
+
 	return Client{
 		healthClient:       health.NewClient(c, baseUrl),
 		pullRequestsClient: pullrequests.NewClient(c, baseUrl),
+		rulesClient:        rules.NewClient(c, baseUrl),
 		statisticsClient:   statistics.NewClient(c, baseUrl),
 		teamsClient:        teams.NewClient(c, baseUrl),
 		usersClient:        users.NewClient(c, baseUrl),
@@ -36,6 +43,10 @@ func (c Client) PR() pullrequests.Client {
 	return c.pullRequestsClient
 }
 
+func (c Client) Rules() rules.Client {
+	return c.rulesClient
+}
+
 func (c Client) Statistics() statistics.Client {
 	return c.statisticsClient
 }
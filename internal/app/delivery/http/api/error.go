@@ -78,3 +78,307 @@ var ErrNotFound = errors.Template{
 		http.WithStatus(gohttp.StatusNotFound),
 	},
 }
+
+var ErrUnknownStrategy = errors.Template{
+	Code:    "UNKNOWN_STRATEGY",
+	Message: "unknown reviewer selection strategy",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrInvalidRequiredReviewers = errors.Template{
+	Code:    "INVALID_REQUIRED_REVIEWERS",
+	Message: "required_reviewers must not be negative",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrInvalidMaxOpenReviews = errors.Template{
+	Code:    "INVALID_MAX_OPEN_REVIEWS",
+	Message: "max_open_reviews must not be negative",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrInvalidUnavailabilityPeriod = errors.Template{
+	Code:    "INVALID_UNAVAILABILITY_PERIOD",
+	Message: "starts_at and ends_at must be provided and ends_at must be after starts_at",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrInvalidCalendar = errors.Template{
+	Code:    "INVALID_CALENDAR",
+	Message: "calendar is not a valid iCalendar document",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrInvalidTimezone = errors.Template{
+	Code:    "INVALID_TIMEZONE",
+	Message: "timezone must be an IANA time zone name",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrInvalidWorkingHours = errors.Template{
+	Code:    "INVALID_WORKING_HOURS",
+	Message: "invalid working hours",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrPeriodIDNotProvided = errors.Template{
+	Code:    "NO_PERIOD_ID",
+	Message: "no period_id provided",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrBulkTargetNotProvided = errors.Template{
+	Code:    "NO_BULK_TARGET",
+	Message: "exactly one of user_ids or team_name must be provided",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrTooManyUsers = errors.Template{
+	Code:    "TOO_MANY_USERS",
+	Message: "too many users in a single request",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrNotTeamMember = errors.Template{
+	Code:    "NOT_TEAM_MEMBER",
+	Message: "user is not a member of the team",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrTeamMemberExists = errors.Template{
+	Code:    "TEAM_MEMBER_EXISTS",
+	Message: "user is already a member of the team",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusConflict),
+	},
+}
+
+var ErrUnknownRole = errors.Template{
+	Code:    "UNKNOWN_ROLE",
+	Message: "unknown team member role",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrUnknownLeadRule = errors.Template{
+	Code:    "UNKNOWN_LEAD_RULE",
+	Message: "unknown lead rule, expected one of none, require_lead, exclude_leads",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrInvalidFallbackTeam = errors.Template{
+	Code:    "INVALID_FALLBACK_TEAM",
+	Message: "team cannot be its own fallback team",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrUnknownRuleKind = errors.Template{
+	Code:    "UNKNOWN_RULE_KIND",
+	Message: "unknown reviewer rule kind, expected one of never_review, prefer",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrInvalidReviewerRule = errors.Template{
+	Code:    "INVALID_REVIEWER_RULE",
+	Message: "author and reviewer of a rule must be different users",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrAlreadyAssigned = errors.Template{
+	Code:    "ALREADY_ASSIGNED",
+	Message: "reviewer is already assigned to this PR",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusConflict),
+	},
+}
+
+var ErrReviewerInactive = errors.Template{
+	Code:    "REVIEWER_INACTIVE",
+	Message: "reviewer is not active",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusConflict),
+	},
+}
+
+var ErrReviewerIsAuthor = errors.Template{
+	Code:    "REVIEWER_IS_AUTHOR",
+	Message: "author cannot review own PR",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusConflict),
+	},
+}
+
+var ErrReviewerForbidden = errors.Template{
+	Code:    "REVIEWER_FORBIDDEN",
+	Message: "author has a never_review rule for this reviewer",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusConflict),
+	},
+}
+
+var ErrReviewerUnavailable = errors.Template{
+	Code:    "REVIEWER_UNAVAILABLE",
+	Message: "reviewer is unavailable",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusConflict),
+	},
+}
+
+var ErrReviewerAtCapacity = errors.Template{
+	Code:    "REVIEWER_AT_CAPACITY",
+	Message: "reviewer has reached the open reviews limit",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusConflict),
+	},
+}
+
+var ErrActorNotProvided = errors.Template{
+	Code:    "ACTOR_NOT_PROVIDED",
+	Message: "request actor is not provided",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusUnauthorized),
+	},
+}
+
+var ErrUnknownActor = errors.Template{
+	Code:    "UNKNOWN_ACTOR",
+	Message: "request actor is not a known user",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusUnauthorized),
+	},
+}
+
+var ErrActorHeaderNotTrusted = errors.Template{
+	Code:    "ACTOR_HEADER_NOT_TRUSTED",
+	Message: "actor header is not accepted, use a bearer token",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusUnauthorized),
+	},
+}
+
+var ErrPullRequestIDNotProvided = errors.Template{
+	Code:    "NO_PULL_REQUEST_ID",
+	Message: "no pull_request_id provided",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrUnknownStatus = errors.Template{
+	Code:    "UNKNOWN_STATUS",
+	Message: "unknown pull request status",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrInvalidCursor = errors.Template{
+	Code:    "INVALID_CURSOR",
+	Message: "invalid pagination cursor",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrInvalidLimit = errors.Template{
+	Code:    "INVALID_LIMIT",
+	Message: "limit must be a positive number not greater than the maximum page size",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrInvalidQueryParam = errors.Template{
+	Code:    "INVALID_QUERY_PARAM",
+	Message: "invalid query parameter",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrInvalidEmail = errors.Template{
+	Code:    "INVALID_EMAIL",
+	Message: "email is not a valid address",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrInvalidUsername = errors.Template{
+	Code:    "INVALID_USERNAME",
+	Message: "username must not be empty",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusBadRequest),
+	},
+}
+
+var ErrEmailTaken = errors.Template{
+	Code:    "EMAIL_TAKEN",
+	Message: "email is already used by another user",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusConflict),
+	},
+}
+
+var ErrUsernameTaken = errors.Template{
+	Code:    "USERNAME_TAKEN",
+	Message: "username is already used by another user",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusConflict),
+	},
+}
+
+var ErrUserIDTaken = errors.Template{
+	Code:    "USER_ID_TAKEN",
+	Message: "user_id is already used by another user",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusConflict),
+	},
+}
+
+var ErrPRNotOpen = errors.Template{
+	Code:    "PR_NOT_OPEN",
+	Message: "reviewers can be changed only on open PR",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusConflict),
+	},
+}
+
+var ErrInvalidStatusTransition = errors.Template{
+	Code:    "INVALID_STATUS_TRANSITION",
+	Message: "PR status does not allow this transition",
+	Params: errors.Params{
+		http.WithStatus(gohttp.StatusConflict),
+	},
+}
@@ -0,0 +1,71 @@
+package pullrequests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type AddReviewerParams struct {
+	PullRequestID string `json:"pull_request_id"`
+	ReviewerID    string `json:"reviewer_id"`
+	// ChangedBy - ID пользователя, выполняющего изменение. Устарело: учитывается, только если
+	// инициатор не передан в Authorization или X-Actor-ID, и, как X-Actor-ID, отклоняется,
+	// если заданы токены и не включен auth.trust_actor_header.
+	ChangedBy string `json:"changed_by,omitempty"`
+}
+
+type AddReviewerResult struct {
+	PR PRResultPR `json:"pr"`
+}
+
+func (c Client) AddReviewer(ctx context.Context, params AddReviewerParams) (AddReviewerResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return AddReviewerResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/pullRequest/addReviewer",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return AddReviewerResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return AddReviewerResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return AddReviewerResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response AddReviewerResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return AddReviewerResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
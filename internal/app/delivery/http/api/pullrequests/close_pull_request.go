@@ -0,0 +1,66 @@
+package pullrequests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type ClosePRParams struct {
+	PullRequestID string `json:"pull_request_id"`
+}
+
+type ClosePRResult struct {
+	PR PRResultPR `json:"pr"`
+}
+
+func (c Client) ClosePR(ctx context.Context, params ClosePRParams) (ClosePRResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return ClosePRResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/pullRequest/close",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return ClosePRResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return ClosePRResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return ClosePRResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response ClosePRResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return ClosePRResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -13,6 +13,9 @@ type CreatePRParams struct {
 	PullRequestID   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
 	AuthorID        string `json:"author_id"`
+	TeamName        string `json:"team_name,omitempty"`
+	// IsDraft создает PR в статусе DRAFT без ревьюверов (см. /pullRequest/markReady).
+	IsDraft bool `json:"is_draft,omitempty"`
 }
 
 type CreatePRResult struct {
@@ -25,6 +28,7 @@ type CreatePRResultPR struct {
 	AuthorID          string   `json:"author_id"`
 	Status            string   `json:"status"`
 	AssignedReviewers []string `json:"assigned_reviewers"`
+	NeedMoreReviewers bool     `json:"need_more_reviewers"`
 }
 
 func (c Client) CreatePR(ctx context.Context, params CreatePRParams) (CreatePRResult, error) {
@@ -0,0 +1,65 @@
+package pullrequests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type GetPRParams struct {
+	PullRequestID string `json:"-"`
+}
+
+type GetPRResult struct {
+	PR PRDetails `json:"pr"`
+}
+
+func (c Client) GetPR(
+	ctx context.Context,
+	params GetPRParams,
+) (GetPRResult, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.baseUrl+"/pullRequest/get",
+		http.NoBody,
+	)
+	if err != nil {
+		return GetPRResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	q := req.URL.Query()
+	q.Add("pull_request_id", params.PullRequestID)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return GetPRResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return GetPRResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response GetPRResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return GetPRResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
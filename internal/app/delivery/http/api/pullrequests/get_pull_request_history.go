@@ -0,0 +1,81 @@
+package pullrequests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+type GetPRHistoryParams struct {
+	PullRequestID string `json:"-"`
+}
+
+type GetPRHistoryResult struct {
+	PullRequestID string                    `json:"pull_request_id"`
+	History       []GetPRHistoryResultEntry `json:"history"`
+}
+
+type GetPRHistoryResultEntry struct {
+	// OldReviewerID - снятый ревьювер (пусто при назначении).
+	OldReviewerID string `json:"old_reviewer_id,omitempty"`
+	// NewReviewerID - назначенный ревьювер (пусто при снятии без замены).
+	NewReviewerID string `json:"new_reviewer_id,omitempty"`
+	// ChangedBy - инициатор изменения (пусто для системных изменений).
+	ChangedBy string `json:"changed_by,omitempty"`
+	// Reason - причина: initial, reassignment, deactivation, top_up, team_leave, manual.
+	Reason string `json:"reason"`
+	// Comment - причина изменения в свободной форме, указанная инициатором.
+	Comment   string    `json:"comment,omitempty"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+func (c Client) GetPRHistory(
+	ctx context.Context,
+	params GetPRHistoryParams,
+) (GetPRHistoryResult, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.baseUrl+"/pullRequest/history",
+		http.NoBody,
+	)
+	if err != nil {
+		return GetPRHistoryResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	q := req.URL.Query()
+	q.Add("pull_request_id", params.PullRequestID)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return GetPRHistoryResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return GetPRHistoryResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response GetPRHistoryResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return GetPRHistoryResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,107 @@
+package pullrequests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ListPRsParams - фильтры списка PR. Пустые значения не ограничивают выборку.
+type ListPRsParams struct {
+	Status            string     `json:"-"`
+	AuthorID          string     `json:"-"`
+	ReviewerID        string     `json:"-"`
+	TeamName          string     `json:"-"`
+	NeedMoreReviewers *bool      `json:"-"`
+	CreatedFrom       *time.Time `json:"-"`
+	CreatedTo         *time.Time `json:"-"`
+	MergedFrom        *time.Time `json:"-"`
+	MergedTo          *time.Time `json:"-"`
+	Cursor            string     `json:"-"`
+	Limit             int        `json:"-"`
+}
+
+type ListPRsResult struct {
+	PullRequests []PRDetails `json:"pull_requests"`
+	// NextCursor - курсор следующей страницы (отсутствует на последней странице).
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+func (c Client) ListPRs(
+	ctx context.Context,
+	params ListPRsParams,
+) (ListPRsResult, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.baseUrl+"/pullRequest/list",
+		http.NoBody,
+	)
+	if err != nil {
+		return ListPRsResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	q := req.URL.Query()
+
+	addString := func(key, value string) {
+		if value != "" {
+			q.Add(key, value)
+		}
+	}
+	addTime := func(key string, value *time.Time) {
+		if value != nil {
+			q.Add(key, value.Format(time.RFC3339Nano))
+		}
+	}
+
+	addString("status", params.Status)
+	addString("author_id", params.AuthorID)
+	addString("reviewer_id", params.ReviewerID)
+	addString("team_name", params.TeamName)
+	if params.NeedMoreReviewers != nil {
+		q.Add("need_more_reviewers", strconv.FormatBool(*params.NeedMoreReviewers))
+	}
+	addTime("created_from", params.CreatedFrom)
+	addTime("created_to", params.CreatedTo)
+	addTime("merged_from", params.MergedFrom)
+	addTime("merged_to", params.MergedTo)
+	addString("cursor", params.Cursor)
+	if params.Limit != 0 {
+		q.Add("limit", strconv.Itoa(params.Limit))
+	}
+
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return ListPRsResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return ListPRsResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response ListPRsResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return ListPRsResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,66 @@
+package pullrequests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type MarkReadyPRParams struct {
+	PullRequestID string `json:"pull_request_id"`
+}
+
+type MarkReadyPRResult struct {
+	PR PRResultPR `json:"pr"`
+}
+
+func (c Client) MarkReadyPR(ctx context.Context, params MarkReadyPRParams) (MarkReadyPRResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return MarkReadyPRResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/pullRequest/markReady",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return MarkReadyPRResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return MarkReadyPRResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return MarkReadyPRResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response MarkReadyPRResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return MarkReadyPRResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
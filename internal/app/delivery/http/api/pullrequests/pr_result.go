@@ -0,0 +1,28 @@
+package pullrequests
+
+import "time"
+
+// PRResultPR - PR с текущим составом ревьюверов.
+type PRResultPR struct {
+	PullRequestID     string   `json:"pull_request_id"`
+	PullRequestName   string   `json:"pull_request_name"`
+	AuthorID          string   `json:"author_id"`
+	Status            string   `json:"status"`
+	AssignedReviewers []string `json:"assigned_reviewers"`
+	NeedMoreReviewers bool     `json:"need_more_reviewers"`
+}
+
+// PRDetails - PR с командой и временем создания и мержа.
+type PRDetails struct {
+	PullRequestID     string   `json:"pull_request_id"`
+	PullRequestName   string   `json:"pull_request_name"`
+	AuthorID          string   `json:"author_id"`
+	Status            string   `json:"status"`
+	AssignedReviewers []string `json:"assigned_reviewers"`
+	NeedMoreReviewers bool     `json:"need_more_reviewers"`
+	// TeamName - команда, из которой назначаются ревьюверы.
+	TeamName  string    `json:"team_name"`
+	CreatedAt time.Time `json:"created_at"`
+	// MergedAt - время мержа (отсутствует, если PR не смержен).
+	MergedAt *time.Time `json:"merged_at,omitempty"`
+}
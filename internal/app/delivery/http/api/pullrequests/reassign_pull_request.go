@@ -12,6 +12,8 @@ import (
 type ReassignPRParams struct {
 	PullRequestID string `json:"pull_request_id"`
 	OldReviewerID string `json:"old_reviewer_id"`
+	// NewReviewerID - выбранная замена; если не задана, подбирается автоматически.
+	NewReviewerID string `json:"new_reviewer_id,omitempty"`
 }
 
 type ReassignPRResult struct {
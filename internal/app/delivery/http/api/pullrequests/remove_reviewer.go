@@ -0,0 +1,71 @@
+package pullrequests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type RemoveReviewerParams struct {
+	PullRequestID string `json:"pull_request_id"`
+	ReviewerID    string `json:"reviewer_id"`
+	// ChangedBy - ID пользователя, выполняющего изменение. Устарело: учитывается, только если
+	// инициатор не передан в Authorization или X-Actor-ID, и, как X-Actor-ID, отклоняется,
+	// если заданы токены и не включен auth.trust_actor_header.
+	ChangedBy string `json:"changed_by,omitempty"`
+}
+
+type RemoveReviewerResult struct {
+	PR PRResultPR `json:"pr"`
+}
+
+func (c Client) RemoveReviewer(ctx context.Context, params RemoveReviewerParams) (RemoveReviewerResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return RemoveReviewerResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/pullRequest/removeReviewer",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return RemoveReviewerResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return RemoveReviewerResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return RemoveReviewerResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response RemoveReviewerResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return RemoveReviewerResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
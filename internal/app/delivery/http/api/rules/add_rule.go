@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type AddRuleParams struct {
+	// Kind - вид правила: never_review или prefer.
+	Kind       string `json:"kind"`
+	AuthorID   string `json:"author_id"`
+	ReviewerID string `json:"reviewer_id"`
+}
+
+type AddRuleResult struct {
+	Rule RuleResult `json:"rule"`
+}
+
+func (c Client) AddRule(
+	ctx context.Context,
+	params AddRuleParams,
+) (AddRuleResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return AddRuleResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/rules/add",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return AddRuleResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return AddRuleResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return AddRuleResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response AddRuleResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return AddRuleResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,60 @@
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type ListRulesParams struct {
+	// AuthorID - если задан, возвращаются только правила этого автора.
+	AuthorID string `json:"-"`
+}
+
+type ListRulesResult struct {
+	Rules []RuleResult `json:"rules"`
+}
+
+func (c Client) ListRules(ctx context.Context, params ListRulesParams) (ListRulesResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/rules/list", http.NoBody)
+	if err != nil {
+		return ListRulesResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	if params.AuthorID != "" {
+		q := req.URL.Query()
+		q.Add("author_id", params.AuthorID)
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return ListRulesResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return ListRulesResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response ListRulesResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return ListRulesResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,8 @@
+package rules
+
+// RuleResult - правило подбора ревьюверов для автора.
+type RuleResult struct {
+	Kind       string `json:"kind"`
+	AuthorID   string `json:"author_id"`
+	ReviewerID string `json:"reviewer_id"`
+}
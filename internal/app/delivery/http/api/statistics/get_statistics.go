@@ -10,6 +10,8 @@ type GetStatisticsResult struct {
 	TotalPRs        int64                 `json:"total_prs"`
 	OpenPRs         int64                 `json:"open_prs"`
 	MergedPRs       int64                 `json:"merged_prs"`
+	ClosedPRs       int64                 `json:"closed_prs"`
+	DraftPRs        int64                 `json:"draft_prs"`
 	UserAssignments []UserAssignmentStats `json:"user_assignments"`
 	TeamStats       []TeamStatistics      `json:"team_stats"`
 	ReviewerLoad    []ReviewerLoadStats   `json:"reviewer_load"`
@@ -10,23 +10,52 @@ import (
 )
 
 type AddTeamParams struct {
-	TeamName string              `json:"team_name"`
-	Members  []AddTeamParamsUser `json:"members"`
+	TeamName          string              `json:"team_name"`
+	ReviewerStrategy  string              `json:"reviewer_strategy,omitempty"`
+	RequiredReviewers *int                `json:"required_reviewers,omitempty"`
+	LeadRule          string              `json:"lead_rule,omitempty"`
+	Members           []AddTeamParamsUser `json:"members"`
+	RemoveMissing     bool                `json:"remove_missing,omitempty"`
+	MoveMembers       bool                `json:"move_members,omitempty"`
 }
 
 type AddTeamParamsUser struct {
 	UserID   string `json:"user_id"`
 	UserName string `json:"username"`
+	// Email - адрес пользователя; если не передан, сохраненный адрес не меняется.
+	Email    string `json:"email,omitempty"`
 	IsActive bool   `json:"is_active"`
 }
 
 type AddTeamResult struct {
 	Team AddTeamResultTeam `json:"team"`
+	Diff AddTeamResultDiff `json:"diff"`
+}
+
+type AddTeamResultDiff struct {
+	TeamCreated             bool                        `json:"team_created"`
+	CreatedUsers            []string                    `json:"created_users"`
+	UpdatedUsers            []string                    `json:"updated_users"`
+	AddedMembers            []string                    `json:"added_members"`
+	RemovedMembers          []string                    `json:"removed_members"`
+	ReassignedPullRequests  []AddTeamResultReassignment `json:"reassigned_pull_requests"`
+	NoCandidatePullRequests []string                    `json:"no_candidate_pull_requests"`
+}
+
+type AddTeamResultReassignment struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	ReplacedBy    string `json:"replaced_by"`
 }
 
 type AddTeamResultTeam struct {
-	TeamName string              `json:"team_name"`
-	Members  []AddTeamResultUser `json:"members"`
+	TeamName           string              `json:"team_name"`
+	ReviewerStrategy   string              `json:"reviewer_strategy"`
+	RequiredReviewers  int                 `json:"required_reviewers"`
+	MaxOpenReviews     int                 `json:"max_open_reviews"`
+	PreferWorkingHours bool                `json:"prefer_working_hours"`
+	LeadRule           string              `json:"lead_rule"`
+	Members            []AddTeamResultUser `json:"members"`
 }
 
 type AddTeamResultUser struct {
@@ -0,0 +1,71 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type AddTeamMemberParams struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+	Role     string `json:"role,omitempty"`
+}
+
+type AddTeamMemberResult struct {
+	Team TeamResultTeam `json:"team"`
+}
+
+func (c Client) AddTeamMember(
+	ctx context.Context,
+	params AddTeamMemberParams,
+) (AddTeamMemberResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return AddTeamMemberResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/teams/members/add",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return AddTeamMemberResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return AddTeamMemberResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return AddTeamMemberResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response AddTeamMemberResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return AddTeamMemberResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
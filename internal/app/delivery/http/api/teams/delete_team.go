@@ -0,0 +1,72 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type DeleteTeamParams struct {
+	TeamName string `json:"team_name"`
+}
+
+type DeleteTeamResult struct {
+	TeamName                string                      `json:"team_name"`
+	RemovedMembers          []string                    `json:"removed_members"`
+	ReassignedPullRequests  []AddTeamResultReassignment `json:"reassigned_pull_requests"`
+	NoCandidatePullRequests []string                    `json:"no_candidate_pull_requests"`
+}
+
+func (c Client) DeleteTeam(
+	ctx context.Context,
+	params DeleteTeamParams,
+) (DeleteTeamResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return DeleteTeamResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/teams/delete",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return DeleteTeamResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return DeleteTeamResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return DeleteTeamResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response DeleteTeamResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return DeleteTeamResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -13,14 +13,21 @@ type GetTeamParams struct {
 }
 
 type GetTeamResult struct {
-	TeamName string              `json:"team_name"`
-	Members  []GetTeamResultUser `json:"members"`
+	TeamName           string              `json:"team_name"`
+	ReviewerStrategy   string              `json:"reviewer_strategy"`
+	RequiredReviewers  int                 `json:"required_reviewers"`
+	MaxOpenReviews     int                 `json:"max_open_reviews"`
+	PreferWorkingHours bool                `json:"prefer_working_hours"`
+	LeadRule           string              `json:"lead_rule"`
+	FallbackTeams      []string            `json:"fallback_teams"`
+	Members            []GetTeamResultUser `json:"members"`
 }
 
 type GetTeamResultUser struct {
 	UserID   string `json:"user_id"`
 	UserName string `json:"username"`
 	IsActive bool   `json:"is_active"`
+	Role     string `json:"role"`
 }
 
 func (c Client) GetTeam(ctx context.Context, params GetTeamParams) (GetTeamResult, error) {
@@ -0,0 +1,72 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type RemoveTeamMemberParams struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+}
+
+type RemoveTeamMemberResult struct {
+	Team                    TeamResultTeam              `json:"team"`
+	ReassignedPullRequests  []AddTeamResultReassignment `json:"reassigned_pull_requests"`
+	NoCandidatePullRequests []string                    `json:"no_candidate_pull_requests"`
+}
+
+func (c Client) RemoveTeamMember(
+	ctx context.Context,
+	params RemoveTeamMemberParams,
+) (RemoveTeamMemberResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return RemoveTeamMemberResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/teams/members/remove",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return RemoveTeamMemberResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return RemoveTeamMemberResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return RemoveTeamMemberResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response RemoveTeamMemberResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return RemoveTeamMemberResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
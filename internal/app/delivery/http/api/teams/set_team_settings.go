@@ -0,0 +1,88 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type SetTeamSettingsParams struct {
+	TeamName          string  `json:"team_name"`
+	ReviewerStrategy  *string `json:"reviewer_strategy,omitempty"`
+	RequiredReviewers *int    `json:"required_reviewers,omitempty"`
+	// MaxOpenReviews - лимит назначений на открытые PR для участников; 0 - значение из конфигурации.
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
+	// PreferWorkingHours - выбирать сначала ревьюверов в рабочих часах.
+	PreferWorkingHours *bool   `json:"prefer_working_hours,omitempty"`
+	LeadRule           *string `json:"lead_rule,omitempty"`
+	// FallbackTeams - резервные команды в порядке обращения к ним; пустой список удаляет все.
+	FallbackTeams *[]string `json:"fallback_teams,omitempty"`
+}
+
+type SetTeamSettingsResult struct {
+	Team SetTeamSettingsResultTeam `json:"team"`
+}
+
+type SetTeamSettingsResultTeam struct {
+	TeamName           string   `json:"team_name"`
+	ReviewerStrategy   string   `json:"reviewer_strategy"`
+	RequiredReviewers  int      `json:"required_reviewers"`
+	MaxOpenReviews     int      `json:"max_open_reviews"`
+	PreferWorkingHours bool     `json:"prefer_working_hours"`
+	LeadRule           string   `json:"lead_rule"`
+	FallbackTeams      []string `json:"fallback_teams"`
+}
+
+func (c Client) SetTeamSettings(
+	ctx context.Context,
+	params SetTeamSettingsParams,
+) (SetTeamSettingsResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return SetTeamSettingsResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/teams/setSettings",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return SetTeamSettingsResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return SetTeamSettingsResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return SetTeamSettingsResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response SetTeamSettingsResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return SetTeamSettingsResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
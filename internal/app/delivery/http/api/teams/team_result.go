@@ -0,0 +1,18 @@
+package teams
+
+type TeamResultTeam struct {
+	TeamName           string           `json:"team_name"`
+	ReviewerStrategy   string           `json:"reviewer_strategy"`
+	RequiredReviewers  int              `json:"required_reviewers"`
+	MaxOpenReviews     int              `json:"max_open_reviews"`
+	PreferWorkingHours bool             `json:"prefer_working_hours"`
+	LeadRule           string           `json:"lead_rule"`
+	Members            []TeamResultUser `json:"members"`
+}
+
+type TeamResultUser struct {
+	UserID   string `json:"user_id"`
+	UserName string `json:"username"`
+	IsActive bool   `json:"is_active"`
+	Role     string `json:"role"`
+}
@@ -0,0 +1,71 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type UpdateTeamParams struct {
+	TeamName    string  `json:"team_name"`
+	NewTeamName *string `json:"new_team_name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type UpdateTeamResult struct {
+	Team TeamResultTeam `json:"team"`
+}
+
+func (c Client) UpdateTeam(
+	ctx context.Context,
+	params UpdateTeamParams,
+) (UpdateTeamResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return UpdateTeamResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/teams/update",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return UpdateTeamResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return UpdateTeamResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return UpdateTeamResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response UpdateTeamResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return UpdateTeamResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,74 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+type AddUnavailabilityParams struct {
+	UserID   string    `json:"user_id"`
+	StartsAt time.Time `json:"starts_at"`
+	// EndsAt - конец периода (не включительно), должен быть позже starts_at.
+	EndsAt time.Time `json:"ends_at"`
+	Reason string    `json:"reason,omitempty"`
+}
+
+type AddUnavailabilityResult struct {
+	Period UnavailabilityPeriodResult `json:"period"`
+}
+
+func (c Client) AddUnavailability(
+	ctx context.Context,
+	params AddUnavailabilityParams,
+) (AddUnavailabilityResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return AddUnavailabilityResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/users/availability/add",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return AddUnavailabilityResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return AddUnavailabilityResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return AddUnavailabilityResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response AddUnavailabilityResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return AddUnavailabilityResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,70 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type AddUserTeamParams struct {
+	UserID    string `json:"user_id"`
+	TeamName  string `json:"team_name"`
+	IsPrimary bool   `json:"is_primary"`
+}
+
+type AddUserTeamResult struct {
+	User UserTeamsResultUser `json:"user"`
+}
+
+func (c Client) AddUserTeam(
+	ctx context.Context,
+	params AddUserTeamParams,
+) (AddUserTeamResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return AddUserTeamResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/users/addTeam",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return AddUserTeamResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return AddUserTeamResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return AddUserTeamResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response AddUserTeamResult
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&response)
+	if err != nil {
+		return AddUserTeamResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,79 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type BulkSetIsActiveParams struct {
+	UserIDs  []string `json:"user_ids,omitempty"`
+	TeamName string   `json:"team_name,omitempty"`
+	IsActive bool     `json:"is_active"`
+}
+
+type BulkSetIsActiveResultUser struct {
+	UserID                  string                          `json:"user_id"`
+	Username                string                          `json:"username"`
+	IsActive                bool                            `json:"is_active"`
+	Changed                 bool                            `json:"changed"`
+	ReassignedPullRequests  []SetIsActiveResultReassignment `json:"reassigned_pull_requests"`
+	NoCandidatePullRequests []string                        `json:"no_candidate_pull_requests"`
+}
+
+type BulkSetIsActiveResult struct {
+	Users []BulkSetIsActiveResultUser `json:"users"`
+}
+
+func (c Client) BulkSetIsActive(
+	ctx context.Context,
+	params BulkSetIsActiveParams,
+) (BulkSetIsActiveResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return BulkSetIsActiveResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/users/bulkSetIsActive",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return BulkSetIsActiveResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return BulkSetIsActiveResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return BulkSetIsActiveResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response BulkSetIsActiveResult
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&response)
+	if err != nil {
+		return BulkSetIsActiveResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,69 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type DeleteUnavailabilityParams struct {
+	PeriodID string `json:"period_id"`
+}
+
+type DeleteUnavailabilityResult struct {
+	PeriodID string `json:"period_id"`
+}
+
+func (c Client) DeleteUnavailability(
+	ctx context.Context,
+	params DeleteUnavailabilityParams,
+) (DeleteUnavailabilityResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return DeleteUnavailabilityResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/users/availability/delete",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return DeleteUnavailabilityResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return DeleteUnavailabilityResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return DeleteUnavailabilityResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response DeleteUnavailabilityResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return DeleteUnavailabilityResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -6,22 +6,31 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 type GetReviewPRsParams struct {
 	UserID string `json:"-"`
+	// Status - статус PR, по умолчанию OPEN.
+	Status string `json:"-"`
+	Cursor string `json:"-"`
+	Limit  int    `json:"-"`
 }
 
 type GetReviewPRsResult struct {
 	UserID       string                 `json:"user_id"`
 	PullRequests []GetReviewPRsResultPR `json:"pull_requests"`
+	// NextCursor - курсор следующей страницы (отсутствует на последней странице).
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 type GetReviewPRsResultPR struct {
-	PullRequestID   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorID        string `json:"author_id"`
-	Status          string `json:"status"`
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        string    `json:"author_id"`
+	Status          string    `json:"status"`
+	AssignedAt      time.Time `json:"assigned_at"`
 }
 
 func (c Client) GetReviewPRs(
@@ -35,6 +44,15 @@ func (c Client) GetReviewPRs(
 
 	q := req.URL.Query()
 	q.Add("user_id", params.UserID)
+	if params.Status != "" {
+		q.Add("status", params.Status)
+	}
+	if params.Cursor != "" {
+		q.Add("cursor", params.Cursor)
+	}
+	if params.Limit != 0 {
+		q.Add("limit", strconv.Itoa(params.Limit))
+	}
 	req.URL.RawQuery = q.Encode()
 
 	resp, err := c.c.Do(req)
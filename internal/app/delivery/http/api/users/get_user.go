@@ -0,0 +1,59 @@
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type GetUserParams struct {
+	UserID string `json:"-"`
+}
+
+type GetUserResult struct {
+	User UserTeamsResultUser `json:"user"`
+}
+
+func (c Client) GetUser(
+	ctx context.Context,
+	params GetUserParams,
+) (GetUserResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/users/get", nil)
+	if err != nil {
+		return GetUserResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	q := req.URL.Query()
+	q.Add("user_id", params.UserID)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return GetUserResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return GetUserResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response GetUserResult
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&response)
+	if err != nil {
+		return GetUserResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,63 @@
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type GetUserLoadParams struct {
+	UserID string `json:"-"`
+}
+
+type GetUserLoadResult struct {
+	UserID      string `json:"user_id"`
+	OpenReviews int64  `json:"open_reviews"`
+	// MaxOpenReviews - действующий лимит назначений на открытые PR (0 - без ограничений).
+	MaxOpenReviews int  `json:"max_open_reviews"`
+	AtCapacity     bool `json:"at_capacity"`
+}
+
+func (c Client) GetUserLoad(
+	ctx context.Context,
+	params GetUserLoadParams,
+) (GetUserLoadResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/users/load", nil)
+	if err != nil {
+		return GetUserLoadResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	q := req.URL.Query()
+	q.Add("user_id", params.UserID)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return GetUserLoadResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return GetUserLoadResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response GetUserLoadResult
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&response)
+	if err != nil {
+		return GetUserLoadResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
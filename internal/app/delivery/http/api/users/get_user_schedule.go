@@ -0,0 +1,59 @@
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type GetUserScheduleParams struct {
+	UserID string `json:"-"`
+}
+
+type GetUserScheduleResult struct {
+	Schedule UserScheduleResult `json:"schedule"`
+}
+
+func (c Client) GetUserSchedule(
+	ctx context.Context,
+	params GetUserScheduleParams,
+) (GetUserScheduleResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/users/schedule", nil)
+	if err != nil {
+		return GetUserScheduleResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	q := req.URL.Query()
+	q.Add("user_id", params.UserID)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return GetUserScheduleResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return GetUserScheduleResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response GetUserScheduleResult
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&response)
+	if err != nil {
+		return GetUserScheduleResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,71 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ImportUnavailabilityParams - документ iCalendar, отправляемый телом запроса с типом text/calendar.
+type ImportUnavailabilityParams struct {
+	Calendar []byte `json:"-"`
+}
+
+type ImportUnavailabilityResult struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+	Deleted int `json:"deleted"`
+	// SkippedEvents - события, которые не удалось импортировать.
+	SkippedEvents int `json:"skipped_events"`
+	// UnmatchedEmails - адреса участников событий, для которых не найден пользователь.
+	UnmatchedEmails []string `json:"unmatched_emails"`
+}
+
+func (c Client) ImportUnavailability(
+	ctx context.Context,
+	params ImportUnavailabilityParams,
+) (ImportUnavailabilityResult, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/users/availability/import",
+		bytes.NewReader(params.Calendar),
+	)
+	if err != nil {
+		return ImportUnavailabilityResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "text/calendar")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return ImportUnavailabilityResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return ImportUnavailabilityResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response ImportUnavailabilityResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return ImportUnavailabilityResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,75 @@
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type ListUnavailabilityParams struct {
+	// UserID - если задан, возвращаются только периоды этого пользователя.
+	UserID string `json:"-"`
+	// IncludePast - возвращать и уже закончившиеся периоды.
+	IncludePast bool `json:"-"`
+}
+
+type ListUnavailabilityResult struct {
+	Periods []UnavailabilityPeriodResult `json:"periods"`
+}
+
+func (c Client) ListUnavailability(
+	ctx context.Context,
+	params ListUnavailabilityParams,
+) (ListUnavailabilityResult, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.baseUrl+"/users/availability/list",
+		http.NoBody,
+	)
+	if err != nil {
+		return ListUnavailabilityResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	q := req.URL.Query()
+	if params.UserID != "" {
+		q.Add("user_id", params.UserID)
+	}
+
+	if params.IncludePast {
+		q.Add("include_past", strconv.FormatBool(params.IncludePast))
+	}
+
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return ListUnavailabilityResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return ListUnavailabilityResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response ListUnavailabilityResult
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&response)
+	if err != nil {
+		return ListUnavailabilityResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
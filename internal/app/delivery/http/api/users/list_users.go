@@ -0,0 +1,84 @@
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// ListUsersParams - фильтры списка пользователей. Пустые значения не ограничивают выборку.
+type ListUsersParams struct {
+	IsActive *bool  `json:"-"`
+	TeamName string `json:"-"`
+	Cursor   string `json:"-"`
+	Limit    int    `json:"-"`
+}
+
+type ListUsersResult struct {
+	Users []ListUsersResultUser `json:"users"`
+	// NextCursor - курсор следующей страницы (отсутствует на последней странице).
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type ListUsersResultUser struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Email    string `json:"email,omitempty"`
+	IsActive bool   `json:"is_active"`
+}
+
+func (c Client) ListUsers(
+	ctx context.Context,
+	params ListUsersParams,
+) (ListUsersResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+"/users/list", nil)
+	if err != nil {
+		return ListUsersResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	q := req.URL.Query()
+	if params.IsActive != nil {
+		q.Add("is_active", strconv.FormatBool(*params.IsActive))
+	}
+	if params.TeamName != "" {
+		q.Add("team_name", params.TeamName)
+	}
+	if params.Cursor != "" {
+		q.Add("cursor", params.Cursor)
+	}
+	if params.Limit != 0 {
+		q.Add("limit", strconv.Itoa(params.Limit))
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return ListUsersResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return ListUsersResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response ListUsersResult
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&response)
+	if err != nil {
+		return ListUsersResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,71 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type RemoveUserTeamParams struct {
+	UserID   string `json:"user_id"`
+	TeamName string `json:"team_name"`
+}
+
+type RemoveUserTeamResult struct {
+	User                    UserTeamsResultUser             `json:"user"`
+	ReassignedPullRequests  []SetIsActiveResultReassignment `json:"reassigned_pull_requests"`
+	NoCandidatePullRequests []string                        `json:"no_candidate_pull_requests"`
+}
+
+func (c Client) RemoveUserTeam(
+	ctx context.Context,
+	params RemoveUserTeamParams,
+) (RemoveUserTeamResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return RemoveUserTeamResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/users/removeTeam",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return RemoveUserTeamResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return RemoveUserTeamResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return RemoveUserTeamResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response RemoveUserTeamResult
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&response)
+	if err != nil {
+		return RemoveUserTeamResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
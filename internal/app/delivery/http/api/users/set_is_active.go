@@ -22,7 +22,15 @@ type SetIsActiveResultUser struct {
 }
 
 type SetIsActiveResult struct {
-	User SetIsActiveResultUser `json:"user"`
+	User                    SetIsActiveResultUser           `json:"user"`
+	ReassignedPullRequests  []SetIsActiveResultReassignment `json:"reassigned_pull_requests"`
+	NoCandidatePullRequests []string                        `json:"no_candidate_pull_requests"`
+}
+
+type SetIsActiveResultReassignment struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	ReplacedBy    string `json:"replaced_by"`
 }
 
 func (c Client) SetIsActive(
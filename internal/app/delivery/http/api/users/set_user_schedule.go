@@ -0,0 +1,74 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SetUserScheduleParams - новый график пользователя; заменяет сохраненный целиком.
+type SetUserScheduleParams struct {
+	UserID string `json:"user_id"`
+	// Timezone - часовой пояс IANA, например Europe/Moscow (пусто - UTC).
+	Timezone string `json:"timezone,omitempty"`
+	// WorkingHours - рабочие интервалы; пустой список снимает ограничение рабочими часами.
+	WorkingHours []WorkingHours `json:"working_hours"`
+}
+
+type SetUserScheduleResult struct {
+	Schedule UserScheduleResult `json:"schedule"`
+}
+
+func (c Client) SetUserSchedule(
+	ctx context.Context,
+	params SetUserScheduleParams,
+) (SetUserScheduleResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return SetUserScheduleResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/users/schedule/set",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return SetUserScheduleResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return SetUserScheduleResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return SetUserScheduleResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response SetUserScheduleResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return SetUserScheduleResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,17 @@
+package users
+
+import "time"
+
+// UnavailabilityPeriodResult - период, в который пользователь не назначается ревьювером.
+type UnavailabilityPeriodResult struct {
+	PeriodID string    `json:"period_id"`
+	UserID   string    `json:"user_id"`
+	StartsAt time.Time `json:"starts_at"`
+	// EndsAt - конец периода (не включительно).
+	EndsAt time.Time `json:"ends_at"`
+	Reason string    `json:"reason,omitempty"`
+	// HandedOff - открытые ревью пользователя уже переданы другим ревьюверам.
+	HandedOff bool `json:"handed_off"`
+	// ExternalUID - UID события календаря, из которого импортирован период.
+	ExternalUID string `json:"external_uid,omitempty"`
+}
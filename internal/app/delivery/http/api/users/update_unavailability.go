@@ -0,0 +1,75 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// UpdateUnavailabilityParams - изменяемые атрибуты периода. Непереданные атрибуты не меняются.
+type UpdateUnavailabilityParams struct {
+	PeriodID string     `json:"period_id"`
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	EndsAt   *time.Time `json:"ends_at,omitempty"`
+	// Reason - новая причина; пустая строка удаляет причину.
+	Reason *string `json:"reason,omitempty"`
+}
+
+type UpdateUnavailabilityResult struct {
+	Period UnavailabilityPeriodResult `json:"period"`
+}
+
+func (c Client) UpdateUnavailability(
+	ctx context.Context,
+	params UpdateUnavailabilityParams,
+) (UpdateUnavailabilityResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return UpdateUnavailabilityResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/users/availability/update",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return UpdateUnavailabilityResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return UpdateUnavailabilityResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return UpdateUnavailabilityResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response UpdateUnavailabilityResult
+
+	decoder := json.NewDecoder(resp.Body)
+
+	err = decoder.Decode(&response)
+	if err != nil {
+		return UpdateUnavailabilityResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
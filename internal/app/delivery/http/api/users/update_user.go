@@ -0,0 +1,76 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// UpdateUserParams - изменяемые атрибуты пользователя. Непереданные атрибуты не меняются.
+type UpdateUserParams struct {
+	UserID string `json:"user_id"`
+	// NewUserID - новый внешний ID пользователя.
+	NewUserID *string `json:"new_user_id,omitempty"`
+	Username  *string `json:"username,omitempty"`
+	// Email - новый адрес; пустая строка удаляет адрес.
+	Email *string `json:"email,omitempty"`
+	// MaxOpenReviews - лимит назначений на открытые PR; 0 - использовать лимит команды.
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
+}
+
+type UpdateUserResult struct {
+	User UserTeamsResultUser `json:"user"`
+}
+
+func (c Client) UpdateUser(
+	ctx context.Context,
+	params UpdateUserParams,
+) (UpdateUserResult, error) {
+	reqBodyBytes, err := json.Marshal(params)
+	if err != nil {
+		return UpdateUserResult{}, fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseUrl+"/users/update",
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return UpdateUserResult{}, fmt.Errorf("error building request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return UpdateUserResult{}, fmt.Errorf("response error: %w", err)
+	}
+
+	defer func(b io.ReadCloser) {
+		_ = b.Close()
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return UpdateUserResult{}, fmt.Errorf(
+			"unsuccessful request: status %d, body: %s",
+			resp.StatusCode,
+			string(body),
+		)
+	}
+
+	var response UpdateUserResult
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&response)
+	if err != nil {
+		return UpdateUserResult{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response, nil
+}
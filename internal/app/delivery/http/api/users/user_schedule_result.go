@@ -0,0 +1,25 @@
+package users
+
+import "time"
+
+// UserScheduleResult - часовой пояс и рабочие часы пользователя.
+type UserScheduleResult struct {
+	UserID string `json:"user_id"`
+	// Timezone - часовой пояс IANA (пусто - UTC).
+	Timezone     string         `json:"timezone,omitempty"`
+	WorkingHours []WorkingHours `json:"working_hours"`
+	// InWorkingHours - пользователь сейчас в рабочих часах (всегда true, если рабочие часы не заданы).
+	InWorkingHours bool `json:"in_working_hours"`
+	// NextWorkingAt - начало ближайших рабочих часов, если пользователь сейчас не в рабочих часах.
+	NextWorkingAt *time.Time `json:"next_working_at,omitempty"`
+}
+
+// WorkingHours - рабочий интервал в один из дней недели в часовом поясе пользователя.
+type WorkingHours struct {
+	// Weekday - день недели: monday, tuesday, ..., sunday.
+	Weekday string `json:"weekday"`
+	// Start - начало интервала в формате HH:MM.
+	Start string `json:"start"`
+	// End - конец интервала в формате HH:MM (не включительно, 24:00 - до конца дня).
+	End string `json:"end"`
+}
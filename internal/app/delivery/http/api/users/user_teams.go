@@ -0,0 +1,16 @@
+package users
+
+type UserTeamsResultUser struct {
+	UserID   string                `json:"user_id"`
+	Username string                `json:"username"`
+	Email    string                `json:"email,omitempty"`
+	TeamName string                `json:"team_name"`
+	IsActive bool                  `json:"is_active"`
+	Teams    []UserTeamsResultTeam `json:"teams"`
+}
+
+type UserTeamsResultTeam struct {
+	TeamName  string `json:"team_name"`
+	Role      string `json:"role"`
+	IsPrimary bool   `json:"is_primary"`
+}
@@ -5,6 +5,7 @@ import (
 
 	"pr-reviewer-assign-service/internal/app/delivery/http/impl/health"
 	"pr-reviewer-assign-service/internal/app/delivery/http/impl/pullrequests"
+	"pr-reviewer-assign-service/internal/app/delivery/http/impl/rules"
 	"pr-reviewer-assign-service/internal/app/delivery/http/impl/statistics"
 	"pr-reviewer-assign-service/internal/app/delivery/http/impl/teams"
 	"pr-reviewer-assign-service/internal/app/delivery/http/impl/users"
@@ -19,11 +20,13 @@ type API struct {
 	healthHandler       *health.Handler
 	statisticsHandler   *statistics.Handler
 	pullRequestsHandler *pullrequests.Handler
+	rulesHandler        *rules.Handler
 	teamsHandler        *teams.Handler
 	usersHandler        *users.Handler
 
 	errorMiddleware  *ErrorMiddleware
 	loggerMiddleware *LogMiddleware
+	actorMiddleware  *ActorMiddleware
 }
 
 // NewAPI
@@ -51,32 +54,83 @@ func NewAPI(cfg *koanf.Koanf, useCase *usecase.UseCase, server *http.Server) *AP
 		healthHandler:       health.NewHandler(useCase),
 		statisticsHandler:   statistics.NewHandler(useCase),
 		pullRequestsHandler: pullrequests.NewHandler(useCase),
+		rulesHandler:        rules.NewHandler(useCase),
 		teamsHandler:        teams.NewHandler(useCase),
 		usersHandler:        users.NewHandler(useCase),
 		errorMiddleware:     NewErrorMiddleware(),
 		loggerMiddleware:    NewLogMiddleware(cfg),
+		actorMiddleware:     NewActorMiddleware(cfg),
 	}
 
 	return api
 }
 
 func (a *API) Init() {
-	teamsGroup := a.server.Group("/teams", a.loggerMiddleware.Call, a.errorMiddleware.Call)
+	teamsGroup := a.server.Group(
+		"/teams",
+		a.loggerMiddleware.Call,
+		a.errorMiddleware.Call,
+		a.actorMiddleware.Call,
+	)
 	teamsGroup.Post("/add", a.teamsHandler.AddTeam)
 	teamsGroup.Get("/get", a.teamsHandler.GetTeam)
+	teamsGroup.Post("/setSettings", a.teamsHandler.SetTeamSettings)
+	teamsGroup.Post("/update", a.teamsHandler.UpdateTeam)
+	teamsGroup.Post("/delete", a.teamsHandler.DeleteTeam)
+	teamsGroup.Post("/members/add", a.teamsHandler.AddTeamMember)
+	teamsGroup.Post("/members/remove", a.teamsHandler.RemoveTeamMember)
+	teamsGroup.Post("/members/setRole", a.teamsHandler.SetTeamMemberRole)
 
-	usersGroup := a.server.Group("/users", a.loggerMiddleware.Call, a.errorMiddleware.Call)
+	usersGroup := a.server.Group(
+		"/users",
+		a.loggerMiddleware.Call,
+		a.errorMiddleware.Call,
+		a.actorMiddleware.Call,
+	)
 	usersGroup.Post("/setIsActive", a.usersHandler.SetIsActive)
+	usersGroup.Post("/bulkSetIsActive", a.usersHandler.BulkSetIsActive)
+	usersGroup.Post("/addTeam", a.usersHandler.AddUserTeam)
+	usersGroup.Post("/removeTeam", a.usersHandler.RemoveUserTeam)
 	usersGroup.Get("/getReview", a.usersHandler.GetReview)
+	usersGroup.Get("/get", a.usersHandler.GetUser)
+	usersGroup.Get("/list", a.usersHandler.ListUsers)
+	usersGroup.Post("/update", a.usersHandler.UpdateUser)
+	usersGroup.Get("/load", a.usersHandler.GetUserLoad)
+	usersGroup.Post("/availability/add", a.usersHandler.AddUnavailability)
+	usersGroup.Post("/availability/update", a.usersHandler.UpdateUnavailability)
+	usersGroup.Post("/availability/delete", a.usersHandler.DeleteUnavailability)
+	usersGroup.Get("/availability/list", a.usersHandler.ListUnavailability)
+	usersGroup.Post("/availability/import", a.usersHandler.ImportUnavailability)
+	usersGroup.Get("/schedule", a.usersHandler.GetUserSchedule)
+	usersGroup.Post("/schedule/set", a.usersHandler.SetUserSchedule)
 
 	pullRequestsGroup := a.server.Group(
 		"/pullRequest",
 		a.loggerMiddleware.Call,
 		a.errorMiddleware.Call,
+		a.actorMiddleware.Call,
 	)
 	pullRequestsGroup.Post("/create", a.pullRequestsHandler.CreatePR)
 	pullRequestsGroup.Post("/merge", a.pullRequestsHandler.MergePR)
+	pullRequestsGroup.Post("/close", a.pullRequestsHandler.ClosePR)
+	pullRequestsGroup.Post("/reopen", a.pullRequestsHandler.ReopenPR)
+	pullRequestsGroup.Post("/markReady", a.pullRequestsHandler.MarkReadyPR)
 	pullRequestsGroup.Post("/reassign", a.pullRequestsHandler.ReassignPR)
+	pullRequestsGroup.Post("/addReviewer", a.pullRequestsHandler.AddReviewer)
+	pullRequestsGroup.Post("/removeReviewer", a.pullRequestsHandler.RemoveReviewer)
+	pullRequestsGroup.Get("/history", a.pullRequestsHandler.GetPRHistory)
+	pullRequestsGroup.Get("/get", a.pullRequestsHandler.GetPR)
+	pullRequestsGroup.Get("/list", a.pullRequestsHandler.ListPRs)
+
+	rulesGroup := a.server.Group(
+		"/rules",
+		a.loggerMiddleware.Call,
+		a.errorMiddleware.Call,
+		a.actorMiddleware.Call,
+	)
+	rulesGroup.Post("/add", a.rulesHandler.AddRule)
+	rulesGroup.Post("/delete", a.rulesHandler.DeleteRule)
+	rulesGroup.Get("/list", a.rulesHandler.ListRules)
 
 	healthGroup := a.server.Group("/health", a.loggerMiddleware.Call, a.errorMiddleware.Call)
 	healthGroup.Get("/livez", a.healthHandler.LiveZ)
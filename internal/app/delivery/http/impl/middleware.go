@@ -3,6 +3,8 @@ package impl
 import (
 	"context"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/knadh/koanf/v2"
@@ -10,6 +12,8 @@ import (
 
 	goerrors "errors"
 
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/actor"
 	"pr-reviewer-assign-service/pkg/errors"
 	httperr "pr-reviewer-assign-service/pkg/errors/http"
 	jsonerr "pr-reviewer-assign-service/pkg/errors/json"
@@ -138,3 +142,66 @@ func (mw *LogMiddleware) Call(ctx *fiber.Ctx) error {
 
 	return nil
 }
+
+// ActorMiddleware определяет инициатора запроса и кладет его в контекст запроса.
+// Инициатор берется из токена в заголовке Authorization (Bearer), а если его нет -
+// из заголовка с ID пользователя, выставляемого аутентифицирующим прокси. Если в конфигурации
+// заданы токены, заголовок принимается только при включенном auth.trust_actor_header.
+// Причина изменения кладется в контекст и без инициатора.
+// В контекст также кладется, можно ли доверять ID пользователя, переданному без токена.
+type ActorMiddleware struct {
+	actorHeader      string
+	reasonHeader     string
+	required         bool
+	trustActorHeader bool
+	tokens           map[string]string
+}
+
+func NewActorMiddleware(cfg *koanf.Koanf) *ActorMiddleware {
+	authCfg := cfg.Cut("auth")
+
+	mw := &ActorMiddleware{
+		actorHeader:  authCfg.String("actor_header"),
+		reasonHeader: authCfg.String("reason_header"),
+		required:     authCfg.Bool("required"),
+		tokens:       authCfg.StringMap("tokens"),
+	}
+
+	mw.trustActorHeader = len(mw.tokens) == 0 || authCfg.Bool("trust_actor_header")
+
+	if mw.actorHeader == "" {
+		mw.actorHeader = api.HeaderActorID
+	}
+
+	if mw.reasonHeader == "" {
+		mw.reasonHeader = api.HeaderChangeReason
+	}
+
+	return mw
+}
+
+func (mw *ActorMiddleware) Call(c *fiber.Ctx) error {
+	reason := c.Get(mw.reasonHeader)
+	if unescaped, err := url.PathUnescape(reason); err == nil {
+		reason = unescaped
+	}
+
+	var userID string
+
+	if token, ok := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), "Bearer "); ok {
+		userID, ok = mw.tokens[token]
+		if !ok {
+			return errors.New(api.ErrUnknownActor)
+		}
+	} else if userID = c.Get(mw.actorHeader); userID != "" && !mw.trustActorHeader {
+		return errors.New(api.ErrActorHeaderNotTrusted)
+	}
+
+	if userID == "" && mw.required && c.Method() != fiber.MethodGet {
+		return errors.New(api.ErrActorNotProvided)
+	}
+
+	actor.SetActor(actor.New(userID, reason, mw.trustActorHeader), c.Context().SetUserValue)
+
+	return c.Next()
+}
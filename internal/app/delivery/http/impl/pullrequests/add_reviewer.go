@@ -0,0 +1,46 @@
+package pullrequests
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/pullrequests"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// AddReviewer
+//
+//	@Summary	Назначить на PR указанного ревьювера в дополнение к текущим
+//	@Tags		PullRequests
+//	@Produce	json
+//	@Param		body	body		pullrequests.AddReviewerParams	true	"pullrequests.AddReviewerParams"
+//	@Success	200		{object}	pullrequests.AddReviewerResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	409		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/pullRequest/addReviewer [post]
+func (h *Handler) AddReviewer(c *fiber.Ctx) error {
+	var request pullrequests.AddReviewerParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.ReviewerID == "" {
+		return errors.New(api.ErrUserIDNotProvided)
+	}
+
+	result, err := h.useCase.AddPullRequestReviewer(c.Context(), usecase.AddPullRequestReviewerParams{
+		PullRequestID: request.PullRequestID,
+		ReviewerID:    request.ReviewerID,
+		ChangedBy:     request.ChangedBy,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(pullrequests.AddReviewerResult{PR: toPRResultPR(result.PR)})
+}
@@ -0,0 +1,44 @@
+package pullrequests
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/pullrequests"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// ClosePR
+//
+//	@Summary	Закрыть PR без мержа (идемпотентная операция)
+//	@Tags		PullRequests
+//	@Produce	json
+//	@Param		body	body		pullrequests.ClosePRParams	true	"pullrequests.ClosePRParams"
+//	@Success	200		{object}	pullrequests.ClosePRResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	409		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/pullRequest/close [post]
+func (h *Handler) ClosePR(c *fiber.Ctx) error {
+	var request pullrequests.ClosePRParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.PullRequestID == "" {
+		return errors.New(api.ErrPullRequestIDNotProvided)
+	}
+
+	result, err := h.useCase.ClosePullRequest(c.Context(), usecase.ClosePullRequestParams{
+		PullRequestID: request.PullRequestID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(pullrequests.ClosePRResult{PR: toPRResultPR(result.PR)})
+}
@@ -0,0 +1,44 @@
+package pullrequests
+
+import (
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/pullrequests"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+)
+
+func toPRResultPR(pr model.PullRequest) pullrequests.PRResultPR {
+	assignedReviewers := pr.AssignedReviewers
+	if assignedReviewers == nil {
+		assignedReviewers = make([]string, 0)
+	}
+
+	return pullrequests.PRResultPR{
+		PullRequestID:     pr.PullRequestID,
+		PullRequestName:   pr.PullRequestName,
+		AuthorID:          pr.AuthorID,
+		Status:            pr.Status,
+		AssignedReviewers: assignedReviewers,
+		NeedMoreReviewers: pr.NeedMoreReviewers,
+	}
+}
+
+func toPRDetails(pr model.PullRequestDetails) pullrequests.PRDetails {
+	result := toPRResultPR(pr.PullRequest)
+
+	details := pullrequests.PRDetails{
+		PullRequestID:     result.PullRequestID,
+		PullRequestName:   result.PullRequestName,
+		AuthorID:          result.AuthorID,
+		Status:            result.Status,
+		AssignedReviewers: result.AssignedReviewers,
+		NeedMoreReviewers: result.NeedMoreReviewers,
+		TeamName:          pr.TeamName,
+		CreatedAt:         pr.CreatedAt,
+	}
+
+	if !pr.MergedAt.IsZero() {
+		mergedAt := pr.MergedAt
+		details.MergedAt = &mergedAt
+	}
+
+	return details
+}
@@ -11,15 +11,16 @@ import (
 
 // CreatePR
 //
-//	@Summary	Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-//	@Tags		PullRequests
-//	@Produce	json
-//	@Param		body	body		pullrequests.CreatePRParams	true	"pullrequests.CreatePRParams"
-//	@Success	200		{object}	pullrequests.CreatePRResult
-//	@Failure	400		{object}	api.ContractError
-//	@Failure	404		{object}	api.ContractError
-//	@Failure	500		{object}	api.ContractError
-//	@Router		/pullRequest/create [post]
+//	@Summary		Создать PR и автоматически назначить ревьюверов из указанной или основной команды автора (по умолчанию до 2)
+//	@Description	Черновик (is_draft) создается без ревьюверов - они назначаются при переводе в OPEN через /pullRequest/markReady.
+//	@Tags			PullRequests
+//	@Produce		json
+//	@Param			body	body		pullrequests.CreatePRParams	true	"pullrequests.CreatePRParams"
+//	@Success		200		{object}	pullrequests.CreatePRResult
+//	@Failure		400		{object}	api.ContractError
+//	@Failure		404		{object}	api.ContractError
+//	@Failure		500		{object}	api.ContractError
+//	@Router			/pullRequest/create [post]
 func (h *Handler) CreatePR(c *fiber.Ctx) error {
 	var request pullrequests.CreatePRParams
 
@@ -32,6 +33,8 @@ func (h *Handler) CreatePR(c *fiber.Ctx) error {
 		PullRequestID:   request.PullRequestID,
 		PullRequestName: request.PullRequestName,
 		AuthorID:        request.AuthorID,
+		TeamName:        request.TeamName,
+		IsDraft:         request.IsDraft,
 	})
 	if err != nil {
 		return err
@@ -43,5 +46,6 @@ func (h *Handler) CreatePR(c *fiber.Ctx) error {
 		AuthorID:          result.PR.AuthorID,
 		Status:            result.PR.Status,
 		AssignedReviewers: result.PR.AssignedReviewers,
+		NeedMoreReviewers: result.PR.NeedMoreReviewers,
 	}})
 }
@@ -0,0 +1,37 @@
+package pullrequests
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/pullrequests"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// GetPR
+//
+//	@Summary	Получить PR по идентификатору
+//	@Tags		PullRequests
+//	@Produce	json
+//	@Param		pull_request_id	query		string	true	"Идентификатор PR"
+//	@Success	200				{object}	pullrequests.GetPRResult
+//	@Failure	400				{object}	api.ContractError
+//	@Failure	404				{object}	api.ContractError
+//	@Failure	500				{object}	api.ContractError
+//	@Router		/pullRequest/get [get]
+func (h *Handler) GetPR(c *fiber.Ctx) error {
+	pullRequestID := c.Query("pull_request_id")
+	if pullRequestID == "" {
+		return errors.New(api.ErrPullRequestIDNotProvided)
+	}
+
+	result, err := h.useCase.GetPullRequest(c.Context(), usecase.GetPullRequestParams{
+		PullRequestID: pullRequestID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(pullrequests.GetPRResult{PR: toPRDetails(result.PR)})
+}
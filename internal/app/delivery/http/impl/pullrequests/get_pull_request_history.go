@@ -0,0 +1,52 @@
+package pullrequests
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/pullrequests"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// GetPRHistory
+//
+//	@Summary	Получить историю назначений ревьюверов PR (в хронологическом порядке)
+//	@Tags		PullRequests
+//	@Produce	json
+//	@Param		pull_request_id	query		string	true	"Идентификатор PR"
+//	@Success	200				{object}	pullrequests.GetPRHistoryResult
+//	@Failure	400				{object}	api.ContractError
+//	@Failure	404				{object}	api.ContractError
+//	@Failure	500				{object}	api.ContractError
+//	@Router		/pullRequest/history [get]
+func (h *Handler) GetPRHistory(c *fiber.Ctx) error {
+	pullRequestID := c.Query("pull_request_id")
+	if pullRequestID == "" {
+		return errors.New(api.ErrPullRequestIDNotProvided)
+	}
+
+	result, err := h.useCase.GetPullRequestHistory(c.Context(), usecase.GetPullRequestHistoryParams{
+		PullRequestID: pullRequestID,
+	})
+	if err != nil {
+		return err
+	}
+
+	history := make([]pullrequests.GetPRHistoryResultEntry, 0, len(result.Entries))
+	for _, entry := range result.Entries {
+		history = append(history, pullrequests.GetPRHistoryResultEntry{
+			OldReviewerID: entry.OldReviewerID,
+			NewReviewerID: entry.NewReviewerID,
+			ChangedBy:     entry.ChangedBy,
+			Reason:        entry.Reason,
+			Comment:       entry.Comment,
+			ChangedAt:     entry.ChangedAt,
+		})
+	}
+
+	return c.JSON(pullrequests.GetPRHistoryResult{
+		PullRequestID: result.PullRequestID,
+		History:       history,
+	})
+}
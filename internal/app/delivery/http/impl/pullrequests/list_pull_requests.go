@@ -0,0 +1,82 @@
+package pullrequests
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/pullrequests"
+	"pr-reviewer-assign-service/internal/app/delivery/http/impl/query"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+)
+
+// ListPRs
+//
+//	@Summary		Получить список PR
+//	@Description	PR упорядочены по времени создания; следующая страница запрашивается по next_cursor.
+//	@Tags			PullRequests
+//	@Produce		json
+//	@Param			status				query		string	false	"Статус PR (OPEN, MERGED, CLOSED, DRAFT)"
+//	@Param			author_id			query		string	false	"Идентификатор автора"
+//	@Param			reviewer_id			query		string	false	"Идентификатор назначенного ревьювера"
+//	@Param			team_name			query		string	false	"Команда PR"
+//	@Param			need_more_reviewers	query		bool	false	"Только PR с недобором ревьюверов (или без него)"
+//	@Param			created_from		query		string	false	"Создан не раньше (RFC3339)"
+//	@Param			created_to			query		string	false	"Создан раньше (RFC3339)"
+//	@Param			merged_from			query		string	false	"Смержен не раньше (RFC3339)"
+//	@Param			merged_to			query		string	false	"Смержен раньше (RFC3339)"
+//	@Param			cursor				query		string	false	"Курсор страницы (next_cursor предыдущего ответа)"
+//	@Param			limit				query		int		false	"Размер страницы (по умолчанию 50, не больше 200)"
+//	@Success		200					{object}	pullrequests.ListPRsResult
+//	@Failure		400					{object}	api.ContractError
+//	@Failure		404					{object}	api.ContractError
+//	@Failure		500					{object}	api.ContractError
+//	@Router			/pullRequest/list [get]
+func (h *Handler) ListPRs(c *fiber.Ctx) error {
+	params := usecase.ListPullRequestsParams{
+		Status:     c.Query("status"),
+		AuthorID:   c.Query("author_id"),
+		ReviewerID: c.Query("reviewer_id"),
+		TeamName:   c.Query("team_name"),
+		Cursor:     c.Query("cursor"),
+	}
+
+	var err error
+
+	if params.NeedMoreReviewers, err = query.Bool(c, "need_more_reviewers"); err != nil {
+		return err
+	}
+
+	if params.CreatedFrom, err = query.Time(c, "created_from"); err != nil {
+		return err
+	}
+
+	if params.CreatedTo, err = query.Time(c, "created_to"); err != nil {
+		return err
+	}
+
+	if params.MergedFrom, err = query.Time(c, "merged_from"); err != nil {
+		return err
+	}
+
+	if params.MergedTo, err = query.Time(c, "merged_to"); err != nil {
+		return err
+	}
+
+	if params.Limit, err = query.Int(c, "limit"); err != nil {
+		return err
+	}
+
+	result, err := h.useCase.ListPullRequests(c.Context(), params)
+	if err != nil {
+		return err
+	}
+
+	prs := make([]pullrequests.PRDetails, 0, len(result.PullRequests))
+	for _, pr := range result.PullRequests {
+		prs = append(prs, toPRDetails(pr))
+	}
+
+	return c.JSON(pullrequests.ListPRsResult{
+		PullRequests: prs,
+		NextCursor:   result.NextCursor,
+	})
+}
@@ -0,0 +1,44 @@
+package pullrequests
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/pullrequests"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// MarkReadyPR
+//
+//	@Summary	Перевести черновик PR в OPEN и назначить ревьюверов
+//	@Tags		PullRequests
+//	@Produce	json
+//	@Param		body	body		pullrequests.MarkReadyPRParams	true	"pullrequests.MarkReadyPRParams"
+//	@Success	200		{object}	pullrequests.MarkReadyPRResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	409		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/pullRequest/markReady [post]
+func (h *Handler) MarkReadyPR(c *fiber.Ctx) error {
+	var request pullrequests.MarkReadyPRParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.PullRequestID == "" {
+		return errors.New(api.ErrPullRequestIDNotProvided)
+	}
+
+	result, err := h.useCase.MarkPullRequestReady(c.Context(), usecase.MarkPullRequestReadyParams{
+		PullRequestID: request.PullRequestID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(pullrequests.MarkReadyPRResult{PR: toPRResultPR(result.PR)})
+}
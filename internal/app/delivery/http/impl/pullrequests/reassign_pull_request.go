@@ -11,7 +11,7 @@ import (
 
 // ReassignPR
 //
-//	@Summary	Переназначить конкретного ревьювера на другого из его команды
+//	@Summary	Переназначить конкретного ревьювера на другого из его команды (выбранного явно или автоматически)
 //	@Tags		PullRequests
 //	@Produce	json
 //	@Param		body	body		pullrequests.ReassignPRParams	true	"pullrequests.ReassignPRParams"
@@ -32,6 +32,7 @@ func (h *Handler) ReassignPR(c *fiber.Ctx) error {
 	result, err := h.useCase.ReassignReviewer(c.Context(), usecase.ReassignReviewerParams{
 		PullRequestID: request.PullRequestID,
 		OldReviewerID: request.OldReviewerID,
+		NewReviewerID: request.NewReviewerID,
 	})
 	if err != nil {
 		return err
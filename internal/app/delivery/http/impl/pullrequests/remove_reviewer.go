@@ -0,0 +1,46 @@
+package pullrequests
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/pullrequests"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// RemoveReviewer
+//
+//	@Summary	Снять ревьювера с PR без замены
+//	@Tags		PullRequests
+//	@Produce	json
+//	@Param		body	body		pullrequests.RemoveReviewerParams	true	"pullrequests.RemoveReviewerParams"
+//	@Success	200		{object}	pullrequests.RemoveReviewerResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	409		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/pullRequest/removeReviewer [post]
+func (h *Handler) RemoveReviewer(c *fiber.Ctx) error {
+	var request pullrequests.RemoveReviewerParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.ReviewerID == "" {
+		return errors.New(api.ErrUserIDNotProvided)
+	}
+
+	result, err := h.useCase.RemovePullRequestReviewer(c.Context(), usecase.RemovePullRequestReviewerParams{
+		PullRequestID: request.PullRequestID,
+		ReviewerID:    request.ReviewerID,
+		ChangedBy:     request.ChangedBy,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(pullrequests.RemoveReviewerResult{PR: toPRResultPR(result.PR)})
+}
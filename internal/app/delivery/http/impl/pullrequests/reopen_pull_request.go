@@ -0,0 +1,44 @@
+package pullrequests
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/pullrequests"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// ReopenPR
+//
+//	@Summary	Переоткрыть закрытый PR (идемпотентная операция)
+//	@Tags		PullRequests
+//	@Produce	json
+//	@Param		body	body		pullrequests.ReopenPRParams	true	"pullrequests.ReopenPRParams"
+//	@Success	200		{object}	pullrequests.ReopenPRResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	409		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/pullRequest/reopen [post]
+func (h *Handler) ReopenPR(c *fiber.Ctx) error {
+	var request pullrequests.ReopenPRParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.PullRequestID == "" {
+		return errors.New(api.ErrPullRequestIDNotProvided)
+	}
+
+	result, err := h.useCase.ReopenPullRequest(c.Context(), usecase.ReopenPullRequestParams{
+		PullRequestID: request.PullRequestID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(pullrequests.ReopenPRResult{PR: toPRResultPR(result.PR)})
+}
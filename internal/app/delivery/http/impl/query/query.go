@@ -0,0 +1,61 @@
+// Package query содержит разбор необязательных query-параметров запросов.
+package query
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// Bool возвращает значение параметра key или nil, если параметр не передан.
+func Bool(c *fiber.Ctx, key string) (*bool, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return nil, invalid(key, "expected boolean")
+	}
+
+	return &value, nil
+}
+
+// Int возвращает значение параметра key или 0, если параметр не передан.
+func Int(c *fiber.Ctx, key string) (int, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, invalid(key, "expected integer")
+	}
+
+	return value, nil
+}
+
+// Time возвращает значение параметра key в формате RFC3339 или nil, если параметр не передан.
+func Time(c *fiber.Ctx, key string) (*time.Time, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil, nil
+	}
+
+	value, err := time.Parse(time.RFC3339Nano, raw)
+	if err != nil {
+		return nil, invalid(key, "expected RFC3339 time")
+	}
+
+	return &value, nil
+}
+
+func invalid(key, reason string) error {
+	return errors.New(api.ErrInvalidQueryParam, errors.WithValidationErrors(map[string]string{key: reason}))
+}
@@ -0,0 +1,45 @@
+package rules
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/rules"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// AddRule
+//
+//	@Summary	Задать правило подбора ревьюверов для автора (never_review - не назначать, prefer - назначать в первую очередь)
+//	@Tags		Rules
+//	@Produce	json
+//	@Param		body	body		rules.AddRuleParams	true	"rules.AddRuleParams"
+//	@Success	200		{object}	rules.AddRuleResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/rules/add [post]
+func (h *Handler) AddRule(c *fiber.Ctx) error {
+	var request rules.AddRuleParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.AuthorID == "" || request.ReviewerID == "" {
+		return errors.New(api.ErrUserIDNotProvided)
+	}
+
+	result, err := h.useCase.AddReviewerRule(c.Context(), usecase.AddReviewerRuleParams{
+		Kind:       request.Kind,
+		AuthorID:   request.AuthorID,
+		ReviewerID: request.ReviewerID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(rules.AddRuleResult{Rule: toRuleResult(result.Rule)})
+}
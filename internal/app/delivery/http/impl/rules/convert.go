@@ -0,0 +1,14 @@
+package rules
+
+import (
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/rules"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+)
+
+func toRuleResult(rule model.ReviewerRule) rules.RuleResult {
+	return rules.RuleResult{
+		Kind:       rule.Kind,
+		AuthorID:   rule.AuthorID,
+		ReviewerID: rule.ReviewerID,
+	}
+}
@@ -0,0 +1,47 @@
+package rules
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/rules"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// DeleteRule
+//
+//	@Summary	Удалить правило подбора ревьюверов для пары автор-ревьювер
+//	@Tags		Rules
+//	@Produce	json
+//	@Param		body	body		rules.DeleteRuleParams	true	"rules.DeleteRuleParams"
+//	@Success	200		{object}	rules.DeleteRuleResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/rules/delete [post]
+func (h *Handler) DeleteRule(c *fiber.Ctx) error {
+	var request rules.DeleteRuleParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.AuthorID == "" || request.ReviewerID == "" {
+		return errors.New(api.ErrUserIDNotProvided)
+	}
+
+	err = h.useCase.DeleteReviewerRule(c.Context(), usecase.DeleteReviewerRuleParams{
+		AuthorID:   request.AuthorID,
+		ReviewerID: request.ReviewerID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(rules.DeleteRuleResult{
+		AuthorID:   request.AuthorID,
+		ReviewerID: request.ReviewerID,
+	})
+}
@@ -0,0 +1,34 @@
+package rules
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/rules"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+)
+
+// ListRules
+//
+//	@Summary	Получить правила подбора ревьюверов
+//	@Tags		Rules
+//	@Produce	json
+//	@Param		author_id	query		string	false	"Внешний ID автора (по умолчанию - все правила)"
+//	@Success	200			{object}	rules.ListRulesResult
+//	@Failure	404			{object}	api.ContractError
+//	@Failure	500			{object}	api.ContractError
+//	@Router		/rules/list [get]
+func (h *Handler) ListRules(c *fiber.Ctx) error {
+	result, err := h.useCase.ListReviewerRules(c.Context(), usecase.ListReviewerRulesParams{
+		AuthorID: c.Query("author_id"),
+	})
+	if err != nil {
+		return err
+	}
+
+	rulesResult := make([]rules.RuleResult, 0, len(result.Rules))
+	for _, rule := range result.Rules {
+		rulesResult = append(rulesResult, toRuleResult(rule))
+	}
+
+	return c.JSON(rules.ListRulesResult{Rules: rulesResult})
+}
@@ -27,6 +27,8 @@ func (h *Handler) GetStatistics(c *fiber.Ctx) error {
 		TotalPRs:        result.TotalPRs,
 		OpenPRs:         result.OpenPRs,
 		MergedPRs:       result.MergedPRs,
+		ClosedPRs:       result.ClosedPRs,
+		DraftPRs:        result.DraftPRs,
 		UserAssignments: make([]statistics.UserAssignmentStats, 0, len(result.UserAssignments)),
 		TeamStats:       make([]statistics.TeamStatistics, 0, len(result.TeamStats)),
 		ReviewerLoad:    make([]statistics.ReviewerLoadStats, 0, len(result.ReviewerLoad)),
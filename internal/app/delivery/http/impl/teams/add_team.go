@@ -10,7 +10,7 @@ import (
 
 // AddTeam
 //
-//	@Summary	Создать команду с участниками (создаёт/обновляет пользователей)
+//	@Summary	Создать или обновить команду с участниками (создаёт/обновляет пользователей, возвращает diff)
 //	@Tags		Teams
 //	@Produce	json
 //	@Param		body	body		teams.AddTeamParams	true	"teams.AddTeamParams"
@@ -28,30 +28,55 @@ func (h *Handler) AddTeam(c *fiber.Ctx) error {
 	}
 
 	members := make([]usecase.TeamMemberParams, 0, len(request.Members))
-	membersResult := make([]teams.AddTeamResultUser, 0, len(request.Members))
 	for _, member := range request.Members {
 		members = append(members, usecase.TeamMemberParams{
 			UserID:   member.UserID,
 			Username: member.UserName,
-			IsActive: member.IsActive,
-		})
-		membersResult = append(membersResult, teams.AddTeamResultUser{
-			UserID:   member.UserID,
-			UserName: member.UserName,
+			Email:    member.Email,
 			IsActive: member.IsActive,
 		})
 	}
 
 	result, err := h.useCase.AddTeam(c.Context(), usecase.AddTeamParams{
-		TeamName: request.TeamName,
-		Members:  members,
+		TeamName:          request.TeamName,
+		ReviewerStrategy:  request.ReviewerStrategy,
+		RequiredReviewers: request.RequiredReviewers,
+		LeadRule:          request.LeadRule,
+		Members:           members,
+		RemoveMissing:     request.RemoveMissing,
+		MoveMembers:       request.MoveMembers,
 	})
 	if err != nil {
 		return err
 	}
 
-	return c.JSON(teams.AddTeamResult{Team: teams.AddTeamResultTeam{
-		TeamName: result.Team.TeamName,
-		Members:  membersResult,
-	}})
+	membersResult := make([]teams.AddTeamResultUser, 0, len(result.Team.Members))
+	for _, member := range result.Team.Members {
+		membersResult = append(membersResult, teams.AddTeamResultUser{
+			UserID:   member.UserID,
+			UserName: member.Username,
+			IsActive: member.IsActive,
+		})
+	}
+
+	return c.JSON(teams.AddTeamResult{
+		Team: teams.AddTeamResultTeam{
+			TeamName:           result.Team.TeamName,
+			ReviewerStrategy:   result.Team.ReviewerStrategy,
+			RequiredReviewers:  result.Team.RequiredReviewers,
+			MaxOpenReviews:     result.Team.MaxOpenReviews,
+			PreferWorkingHours: result.Team.PreferWorkingHours,
+			LeadRule:           result.Team.LeadRule,
+			Members:            membersResult,
+		},
+		Diff: teams.AddTeamResultDiff{
+			TeamCreated:             result.Diff.TeamCreated,
+			CreatedUsers:            nonNil(result.Diff.CreatedUsers),
+			UpdatedUsers:            nonNil(result.Diff.UpdatedUsers),
+			AddedMembers:            nonNil(result.Diff.AddedMembers),
+			RemovedMembers:          nonNil(result.Diff.RemovedMembers),
+			ReassignedPullRequests:  toReassignments(result.Diff.HandOff),
+			NoCandidatePullRequests: nonNil(result.Diff.HandOff.NoCandidate),
+		},
+	})
 }
@@ -0,0 +1,50 @@
+package teams
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/teams"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// AddTeamMember
+//
+//	@Summary	Добавить существующего пользователя в команду
+//	@Tags		Teams
+//	@Produce	json
+//	@Param		body	body		teams.AddTeamMemberParams	true	"teams.AddTeamMemberParams"
+//	@Success	200		{object}	teams.AddTeamMemberResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	409		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/team/members/add [post]
+func (h *Handler) AddTeamMember(c *fiber.Ctx) error {
+	var request teams.AddTeamMemberParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.TeamName == "" {
+		return errors.New(api.ErrTeamNameNotProvided)
+	}
+
+	if request.UserID == "" {
+		return errors.New(api.ErrUserIDNotProvided)
+	}
+
+	result, err := h.useCase.AddTeamMember(c.Context(), usecase.AddTeamMemberParams{
+		TeamName: request.TeamName,
+		UserID:   request.UserID,
+		Role:     request.Role,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(teams.AddTeamMemberResult{Team: toTeamResultTeam(result.Team)})
+}
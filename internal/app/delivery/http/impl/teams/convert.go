@@ -0,0 +1,52 @@
+package teams
+
+import (
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/teams"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+)
+
+func toTeamResultTeam(team model.Team) teams.TeamResultTeam {
+	result := teams.TeamResultTeam{
+		TeamName:           team.TeamName,
+		ReviewerStrategy:   team.ReviewerStrategy,
+		RequiredReviewers:  team.RequiredReviewers,
+		MaxOpenReviews:     team.MaxOpenReviews,
+		PreferWorkingHours: team.PreferWorkingHours,
+		LeadRule:           team.LeadRule,
+		Members:            make([]teams.TeamResultUser, 0, len(team.Members)),
+	}
+
+	for _, member := range team.Members {
+		result.Members = append(result.Members, teams.TeamResultUser{
+			UserID:   member.UserID,
+			UserName: member.Username,
+			IsActive: member.IsActive,
+			Role:     member.Role,
+		})
+	}
+
+	return result
+}
+
+func toReassignments(handOff usecase.ReviewHandOff) []teams.AddTeamResultReassignment {
+	reassigned := make([]teams.AddTeamResultReassignment, 0, len(handOff.Reassigned))
+	for _, review := range handOff.Reassigned {
+		reassigned = append(reassigned, teams.AddTeamResultReassignment{
+			PullRequestID: review.PullRequestID,
+			OldReviewerID: review.OldReviewerID,
+			ReplacedBy:    review.NewReviewerID,
+		})
+	}
+
+	return reassigned
+}
+
+// nonNil заменяет nil на пустой срез, чтобы в ответе был [] вместо null.
+func nonNil(ids []string) []string {
+	if ids == nil {
+		return make([]string, 0)
+	}
+
+	return ids
+}
@@ -0,0 +1,48 @@
+package teams
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/teams"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// DeleteTeam
+//
+//	@Summary	Удалить команду (открытые ревью участников из этой команды передаются коллегам)
+//	@Tags		Teams
+//	@Produce	json
+//	@Param		body	body		teams.DeleteTeamParams	true	"teams.DeleteTeamParams"
+//	@Success	200		{object}	teams.DeleteTeamResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/team/delete [post]
+func (h *Handler) DeleteTeam(c *fiber.Ctx) error {
+	var request teams.DeleteTeamParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.TeamName == "" {
+		return errors.New(api.ErrTeamNameNotProvided)
+	}
+
+	result, err := h.useCase.DeleteTeam(c.Context(), usecase.DeleteTeamParams{
+		TeamName: request.TeamName,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(teams.DeleteTeamResult{
+		TeamName:                result.TeamName,
+		RemovedMembers:          nonNil(result.RemovedMembers),
+		ReassignedPullRequests:  toReassignments(result.HandOff),
+		NoCandidatePullRequests: nonNil(result.HandOff.NoCandidate),
+	})
+}
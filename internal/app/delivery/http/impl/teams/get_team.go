@@ -39,11 +39,18 @@ func (h *Handler) GetTeam(c *fiber.Ctx) error {
 			UserID:   member.UserID,
 			UserName: member.Username,
 			IsActive: member.IsActive,
+			Role:     member.Role,
 		})
 	}
 
 	return c.JSON(teams.GetTeamResult{
-		TeamName: result.Team.TeamName,
-		Members:  membersResult,
+		TeamName:           result.Team.TeamName,
+		ReviewerStrategy:   result.Team.ReviewerStrategy,
+		RequiredReviewers:  result.Team.RequiredReviewers,
+		MaxOpenReviews:     result.Team.MaxOpenReviews,
+		PreferWorkingHours: result.Team.PreferWorkingHours,
+		LeadRule:           result.Team.LeadRule,
+		FallbackTeams:      nonNil(result.Team.FallbackTeams),
+		Members:            membersResult,
 	})
 }
@@ -0,0 +1,52 @@
+package teams
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/teams"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// RemoveTeamMember
+//
+//	@Summary	Исключить участника из команды (его открытые ревью из этой команды передаются коллегам)
+//	@Tags		Teams
+//	@Produce	json
+//	@Param		body	body		teams.RemoveTeamMemberParams	true	"teams.RemoveTeamMemberParams"
+//	@Success	200		{object}	teams.RemoveTeamMemberResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/team/members/remove [post]
+func (h *Handler) RemoveTeamMember(c *fiber.Ctx) error {
+	var request teams.RemoveTeamMemberParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.TeamName == "" {
+		return errors.New(api.ErrTeamNameNotProvided)
+	}
+
+	if request.UserID == "" {
+		return errors.New(api.ErrUserIDNotProvided)
+	}
+
+	result, err := h.useCase.RemoveTeamMember(c.Context(), usecase.RemoveTeamMemberParams{
+		TeamName: request.TeamName,
+		UserID:   request.UserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(teams.RemoveTeamMemberResult{
+		Team:                    toTeamResultTeam(result.Team),
+		ReassignedPullRequests:  toReassignments(result.HandOff),
+		NoCandidatePullRequests: nonNil(result.HandOff.NoCandidate),
+	})
+}
@@ -0,0 +1,49 @@
+package teams
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/teams"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// SetTeamMemberRole
+//
+//	@Summary	Изменить роль участника команды (MEMBER, LEAD)
+//	@Tags		Teams
+//	@Produce	json
+//	@Param		body	body		teams.SetTeamMemberRoleParams	true	"teams.SetTeamMemberRoleParams"
+//	@Success	200		{object}	teams.SetTeamMemberRoleResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/team/members/setRole [post]
+func (h *Handler) SetTeamMemberRole(c *fiber.Ctx) error {
+	var request teams.SetTeamMemberRoleParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.TeamName == "" {
+		return errors.New(api.ErrTeamNameNotProvided)
+	}
+
+	if request.UserID == "" {
+		return errors.New(api.ErrUserIDNotProvided)
+	}
+
+	result, err := h.useCase.SetTeamMemberRole(c.Context(), usecase.SetTeamMemberRoleParams{
+		TeamName: request.TeamName,
+		UserID:   request.UserID,
+		Role:     request.Role,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(teams.SetTeamMemberRoleResult{Team: toTeamResultTeam(result.Team)})
+}
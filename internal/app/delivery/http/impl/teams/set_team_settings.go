@@ -0,0 +1,57 @@
+package teams
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/teams"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// SetTeamSettings
+//
+//	@Summary	Изменить настройки назначения ревьюверов команды (стратегия, количество ревьюверов, лимит назначений, правило LEAD, резервные команды)
+//	@Tags		Teams
+//	@Produce	json
+//	@Param		body	body		teams.SetTeamSettingsParams	true	"teams.SetTeamSettingsParams"
+//	@Success	200		{object}	teams.SetTeamSettingsResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/team/setSettings [post]
+func (h *Handler) SetTeamSettings(c *fiber.Ctx) error {
+	var request teams.SetTeamSettingsParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.TeamName == "" {
+		return errors.New(api.ErrTeamNameNotProvided)
+	}
+
+	result, err := h.useCase.SetTeamSettings(c.Context(), usecase.SetTeamSettingsParams{
+		TeamName:           request.TeamName,
+		ReviewerStrategy:   request.ReviewerStrategy,
+		RequiredReviewers:  request.RequiredReviewers,
+		MaxOpenReviews:     request.MaxOpenReviews,
+		PreferWorkingHours: request.PreferWorkingHours,
+		LeadRule:           request.LeadRule,
+		FallbackTeams:      request.FallbackTeams,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(teams.SetTeamSettingsResult{Team: teams.SetTeamSettingsResultTeam{
+		TeamName:           result.Team.TeamName,
+		ReviewerStrategy:   result.Team.ReviewerStrategy,
+		RequiredReviewers:  result.Team.RequiredReviewers,
+		MaxOpenReviews:     result.Team.MaxOpenReviews,
+		PreferWorkingHours: result.Team.PreferWorkingHours,
+		LeadRule:           result.Team.LeadRule,
+		FallbackTeams:      nonNil(result.Team.FallbackTeams),
+	}})
+}
@@ -0,0 +1,45 @@
+package teams
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/teams"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// UpdateTeam
+//
+//	@Summary	Переименовать команду или изменить ее описание
+//	@Tags		Teams
+//	@Produce	json
+//	@Param		body	body		teams.UpdateTeamParams	true	"teams.UpdateTeamParams"
+//	@Success	200		{object}	teams.UpdateTeamResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/team/update [post]
+func (h *Handler) UpdateTeam(c *fiber.Ctx) error {
+	var request teams.UpdateTeamParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.TeamName == "" {
+		return errors.New(api.ErrTeamNameNotProvided)
+	}
+
+	result, err := h.useCase.UpdateTeam(c.Context(), usecase.UpdateTeamParams{
+		TeamName:    request.TeamName,
+		NewTeamName: request.NewTeamName,
+		Description: request.Description,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(teams.UpdateTeamResult{Team: toTeamResultTeam(result.Team)})
+}
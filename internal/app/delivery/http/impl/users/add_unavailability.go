@@ -0,0 +1,49 @@
+package users
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// AddUnavailability adds user unavailability period
+//
+//	@Summary		Добавить период недоступности пользователя (отпуск, отсутствие)
+//	@Description	В этот период пользователь не назначается ревьювером автоматически. Открытые ревью передаются другим ревьюверам фоновой задачей после начала периода, если она включена.
+//	@Tags			Users
+//	@Produce		json
+//	@Param			body	body		users.AddUnavailabilityParams	true	"users.AddUnavailabilityParams"
+//	@Success		200		{object}	users.AddUnavailabilityResult
+//	@Failure		400		{object}	api.ContractError
+//	@Failure		404		{object}	api.ContractError
+//	@Failure		500		{object}	api.ContractError
+//	@Router			/users/availability/add [post]
+func (h *Handler) AddUnavailability(c *fiber.Ctx) error {
+	var request users.AddUnavailabilityParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.UserID == "" {
+		return errors.New(api.ErrUserIDNotProvided)
+	}
+
+	result, err := h.useCase.AddUnavailabilityPeriod(c.Context(), usecase.AddUnavailabilityPeriodParams{
+		UserID:   request.UserID,
+		StartsAt: request.StartsAt,
+		EndsAt:   request.EndsAt,
+		Reason:   request.Reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(users.AddUnavailabilityResult{
+		Period: toUnavailabilityPeriodResult(result.Period),
+	})
+}
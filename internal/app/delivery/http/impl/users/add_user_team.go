@@ -0,0 +1,47 @@
+package users
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// AddUserTeam adds user to team
+//
+//	@Summary	Добавить пользователя в команду (опционально сделать ее основной)
+//	@Tags		Users
+//	@Produce	json
+//	@Param		body	body		users.AddUserTeamParams	true	"users.AddUserTeamParams"
+//	@Success	200		{object}	users.AddUserTeamResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/users/addTeam [post]
+func (h *Handler) AddUserTeam(c *fiber.Ctx) error {
+	var request users.AddUserTeamParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	result, err := h.useCase.AddUserTeam(c.Context(), usecase.AddUserTeamParams{
+		UserID:    request.UserID,
+		TeamName:  request.TeamName,
+		IsPrimary: request.IsPrimary,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = c.JSON(users.AddUserTeamResult{
+		User: toUserTeamsResultUser(result.User, result.Teams),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package users
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// BulkSetIsActive sets activity flag for several users at once
+//
+//	@Summary	Массово установить флаг активности пользователям (по списку ID или по команде) с передачей открытых ревью
+//	@Tags		Users
+//	@Produce	json
+//	@Param		body	body		users.BulkSetIsActiveParams	true	"users.BulkSetIsActiveParams"
+//	@Success	200		{object}	users.BulkSetIsActiveResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/users/bulkSetIsActive [post]
+func (h *Handler) BulkSetIsActive(c *fiber.Ctx) error {
+	var request users.BulkSetIsActiveParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	result, err := h.useCase.BulkSetIsActive(c.Context(), usecase.BulkSetIsActiveParams{
+		UserIDs:  request.UserIDs,
+		TeamName: request.TeamName,
+		IsActive: request.IsActive,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := users.BulkSetIsActiveResult{
+		Users: make([]users.BulkSetIsActiveResultUser, 0, len(result.Users)),
+	}
+
+	for _, user := range result.Users {
+		response.Users = append(response.Users, users.BulkSetIsActiveResultUser{
+			UserID:                  user.User.UserID,
+			Username:                user.User.UserName,
+			IsActive:                user.User.IsActive,
+			Changed:                 user.Changed,
+			ReassignedPullRequests:  toReassignments(user.HandOff),
+			NoCandidatePullRequests: toNoCandidate(user.HandOff),
+		})
+	}
+
+	err = c.JSON(response)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package users
+
+import (
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+)
+
+func toUserTeamsResultUser(user model.User, teams []model.UserTeam) users.UserTeamsResultUser {
+	result := users.UserTeamsResultUser{
+		UserID:   user.UserID,
+		Username: user.UserName,
+		Email:    user.Email,
+		TeamName: user.TeamName,
+		IsActive: user.IsActive,
+		Teams:    make([]users.UserTeamsResultTeam, 0, len(teams)),
+	}
+
+	for _, team := range teams {
+		result.Teams = append(result.Teams, users.UserTeamsResultTeam{
+			TeamName:  team.TeamName,
+			Role:      team.Role,
+			IsPrimary: team.IsPrimary,
+		})
+	}
+
+	return result
+}
+
+func toReassignments(handOff usecase.ReviewHandOff) []users.SetIsActiveResultReassignment {
+	reassigned := make([]users.SetIsActiveResultReassignment, 0, len(handOff.Reassigned))
+	for _, review := range handOff.Reassigned {
+		reassigned = append(reassigned, users.SetIsActiveResultReassignment{
+			PullRequestID: review.PullRequestID,
+			OldReviewerID: review.OldReviewerID,
+			ReplacedBy:    review.NewReviewerID,
+		})
+	}
+
+	return reassigned
+}
+
+func toNoCandidate(handOff usecase.ReviewHandOff) []string {
+	noCandidate := make([]string, 0, len(handOff.NoCandidate))
+
+	return append(noCandidate, handOff.NoCandidate...)
+}
+
+func toUnavailabilityPeriodResult(period model.UnavailabilityPeriod) users.UnavailabilityPeriodResult {
+	return users.UnavailabilityPeriodResult{
+		PeriodID:    period.PeriodID,
+		UserID:      period.UserID,
+		StartsAt:    period.StartsAt,
+		EndsAt:      period.EndsAt,
+		Reason:      period.Reason,
+		HandedOff:   period.HandedOff,
+		ExternalUID: period.ExternalUID,
+	}
+}
+
+func toUserScheduleResult(schedule model.UserSchedule) users.UserScheduleResult {
+	result := users.UserScheduleResult{
+		UserID:         schedule.UserID,
+		Timezone:       schedule.Timezone,
+		WorkingHours:   make([]users.WorkingHours, 0, len(schedule.WorkingHours)),
+		InWorkingHours: schedule.InWorkingHours,
+	}
+
+	for _, interval := range schedule.WorkingHours {
+		result.WorkingHours = append(result.WorkingHours, users.WorkingHours{
+			Weekday: interval.Weekday,
+			Start:   interval.Start,
+			End:     interval.End,
+		})
+	}
+
+	if !schedule.NextWorkingAt.IsZero() {
+		nextWorkingAt := schedule.NextWorkingAt
+		result.NextWorkingAt = &nextWorkingAt
+	}
+
+	return result
+}
@@ -0,0 +1,43 @@
+package users
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// DeleteUnavailability deletes user unavailability period
+//
+//	@Summary	Удалить период недоступности пользователя
+//	@Tags		Users
+//	@Produce	json
+//	@Param		body	body		users.DeleteUnavailabilityParams	true	"users.DeleteUnavailabilityParams"
+//	@Success	200		{object}	users.DeleteUnavailabilityResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/users/availability/delete [post]
+func (h *Handler) DeleteUnavailability(c *fiber.Ctx) error {
+	var request users.DeleteUnavailabilityParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.PeriodID == "" {
+		return errors.New(api.ErrPeriodIDNotProvided)
+	}
+
+	err = h.useCase.DeleteUnavailabilityPeriod(c.Context(), usecase.DeleteUnavailabilityPeriodParams{
+		PeriodID: request.PeriodID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(users.DeleteUnavailabilityResult{PeriodID: request.PeriodID})
+}
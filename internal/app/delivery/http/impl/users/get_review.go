@@ -5,28 +5,43 @@ import (
 
 	"pr-reviewer-assign-service/internal/app/delivery/http/api"
 	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/delivery/http/impl/query"
 	"pr-reviewer-assign-service/internal/app/domain/usecase"
 	"pr-reviewer-assign-service/pkg/errors"
 )
 
 // GetReview gets pending for review PRs of user
 //
-//	@Summary	Получить PR'ы, где пользователь назначен ревьювером
-//	@Tags		Users
-//	@Produce	json
-//	@Param		user_id	query		string	true	"User ID"
-//	@Success	200		{object}	GetReviewPRsResult
-//	@Failure	400		{object}	api.ContractError
-//	@Failure	404		{object}	api.ContractError
-//	@Failure	500		{object}	api.ContractError
-//	@Router		/users/getReview [get]
+//	@Summary		Получить PR'ы, где пользователь назначен ревьювером
+//	@Description	PR упорядочены по времени назначения; следующая страница запрашивается по next_cursor.
+//	@Tags			Users
+//	@Produce		json
+//	@Param			user_id	query		string	true	"User ID"
+//	@Param			status	query		string	false	"Статус PR (по умолчанию OPEN)"
+//	@Param			cursor	query		string	false	"Курсор страницы (next_cursor предыдущего ответа)"
+//	@Param			limit	query		int		false	"Размер страницы (по умолчанию 50, не больше 200)"
+//	@Success		200		{object}	GetReviewPRsResult
+//	@Failure		400		{object}	api.ContractError
+//	@Failure		404		{object}	api.ContractError
+//	@Failure		500		{object}	api.ContractError
+//	@Router			/users/getReview [get]
 func (h *Handler) GetReview(c *fiber.Ctx) error {
 	userID := c.Query("user_id")
 	if userID == "" {
 		return errors.New(api.ErrUserIDNotProvided)
 	}
 
-	result, err := h.useCase.GetReview(c.Context(), usecase.GetReviewParams{UserID: userID})
+	limit, err := query.Int(c, "limit")
+	if err != nil {
+		return err
+	}
+
+	result, err := h.useCase.GetReview(c.Context(), usecase.GetReviewParams{
+		UserID: userID,
+		Status: c.Query("status"),
+		Cursor: c.Query("cursor"),
+		Limit:  limit,
+	})
 	if err != nil {
 		return err
 	}
@@ -38,12 +53,14 @@ func (h *Handler) GetReview(c *fiber.Ctx) error {
 			PullRequestName: pr.PullRequestName,
 			AuthorID:        pr.AuthorID,
 			Status:          pr.Status,
+			AssignedAt:      pr.AssignedAt,
 		})
 	}
 
 	err = c.JSON(users.GetReviewPRsResult{
 		UserID:       result.UserID,
 		PullRequests: pullRequestsResult,
+		NextCursor:   result.NextCursor,
 	})
 	if err != nil {
 		return err
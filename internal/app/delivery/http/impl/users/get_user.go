@@ -0,0 +1,37 @@
+package users
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// GetUser gets user with teams
+//
+//	@Summary	Получить пользователя с его командами
+//	@Tags		Users
+//	@Produce	json
+//	@Param		user_id	query		string	true	"User ID"
+//	@Success	200		{object}	users.GetUserResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/users/get [get]
+func (h *Handler) GetUser(c *fiber.Ctx) error {
+	userID := c.Query("user_id")
+	if userID == "" {
+		return errors.New(api.ErrUserIDNotProvided)
+	}
+
+	result, err := h.useCase.GetUser(c.Context(), usecase.GetUserParams{UserID: userID})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(users.GetUserResult{
+		User: toUserTeamsResultUser(result.User, result.Teams),
+	})
+}
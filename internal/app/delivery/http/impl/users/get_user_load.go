@@ -0,0 +1,40 @@
+package users
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// GetUserLoad gets user review load
+//
+//	@Summary	Получить текущую нагрузку пользователя и его лимит назначений на открытые PR
+//	@Tags		Users
+//	@Produce	json
+//	@Param		user_id	query		string	true	"User ID"
+//	@Success	200		{object}	users.GetUserLoadResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/users/load [get]
+func (h *Handler) GetUserLoad(c *fiber.Ctx) error {
+	userID := c.Query("user_id")
+	if userID == "" {
+		return errors.New(api.ErrUserIDNotProvided)
+	}
+
+	result, err := h.useCase.GetUserLoad(c.Context(), usecase.GetUserLoadParams{UserID: userID})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(users.GetUserLoadResult{
+		UserID:         result.Load.UserID,
+		OpenReviews:    result.Load.OpenReviews,
+		MaxOpenReviews: result.Load.MaxOpenReviews,
+		AtCapacity:     result.Load.AtCapacity,
+	})
+}
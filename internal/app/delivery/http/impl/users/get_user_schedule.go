@@ -0,0 +1,37 @@
+package users
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// GetUserSchedule gets user time zone and working hours
+//
+//	@Summary	Получить часовой пояс и рабочие часы пользователя
+//	@Tags		Users
+//	@Produce	json
+//	@Param		user_id	query		string	true	"User ID"
+//	@Success	200		{object}	users.GetUserScheduleResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/users/schedule [get]
+func (h *Handler) GetUserSchedule(c *fiber.Ctx) error {
+	userID := c.Query("user_id")
+	if userID == "" {
+		return errors.New(api.ErrUserIDNotProvided)
+	}
+
+	result, err := h.useCase.GetUserSchedule(c.Context(), usecase.GetUserScheduleParams{UserID: userID})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(users.GetUserScheduleResult{
+		Schedule: toUserScheduleResult(result.Schedule),
+	})
+}
@@ -0,0 +1,57 @@
+package users
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// ImportUnavailability imports user unavailability periods from iCalendar
+//
+//	@Summary		Импортировать периоды недоступности из календаря iCalendar (.ics)
+//	@Description	Календарь передается файлом в поле file (multipart/form-data) или телом запроса с типом text/calendar. Импортируются события, помеченные как отсутствие (Outlook OOF или категория Out of office / Vacation); пользователи сопоставляются по email участников. Повторный импорт идемпотентен: периоды связаны с UID событий.
+//	@Tags			Users
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			file	formData	file	false	"Файл календаря .ics"
+//	@Success		200		{object}	users.ImportUnavailabilityResult
+//	@Failure		400		{object}	api.ContractError
+//	@Failure		500		{object}	api.ContractError
+//	@Router			/users/availability/import [post]
+func (h *Handler) ImportUnavailability(c *fiber.Ctx) error {
+	var calendar io.Reader = bytes.NewReader(c.Body())
+
+	fileHeader, err := c.FormFile("file")
+	if err == nil {
+		file, err := fileHeader.Open()
+		if err != nil {
+			return errors.Wrap(err, errors.InternalError)
+		}
+
+		defer func() {
+			_ = file.Close()
+		}()
+
+		calendar = file
+	}
+
+	result, err := h.useCase.ImportUnavailability(c.Context(), usecase.ImportUnavailabilityParams{
+		Calendar: calendar,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(users.ImportUnavailabilityResult{
+		Created:         result.Created,
+		Updated:         result.Updated,
+		Deleted:         result.Deleted,
+		SkippedEvents:   result.SkippedEvents,
+		UnmatchedEmails: result.UnmatchedEmails,
+	})
+}
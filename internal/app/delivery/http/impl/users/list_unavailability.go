@@ -0,0 +1,46 @@
+package users
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/delivery/http/impl/query"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+)
+
+// ListUnavailability lists user unavailability periods
+//
+//	@Summary	Получить периоды недоступности пользователей
+//	@Tags		Users
+//	@Produce	json
+//	@Param		user_id			query		string	false	"Внешний ID пользователя (по умолчанию - все пользователи)"
+//	@Param		include_past	query		bool	false	"Возвращать и закончившиеся периоды"
+//	@Success	200				{object}	users.ListUnavailabilityResult
+//	@Failure	400				{object}	api.ContractError
+//	@Failure	404				{object}	api.ContractError
+//	@Failure	500				{object}	api.ContractError
+//	@Router		/users/availability/list [get]
+func (h *Handler) ListUnavailability(c *fiber.Ctx) error {
+	includePast, err := query.Bool(c, "include_past")
+	if err != nil {
+		return err
+	}
+
+	result, err := h.useCase.ListUnavailabilityPeriods(
+		c.Context(),
+		usecase.ListUnavailabilityPeriodsParams{
+			UserID:      c.Query("user_id"),
+			IncludePast: includePast != nil && *includePast,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	periods := make([]users.UnavailabilityPeriodResult, 0, len(result.Periods))
+	for _, period := range result.Periods {
+		periods = append(periods, toUnavailabilityPeriodResult(period))
+	}
+
+	return c.JSON(users.ListUnavailabilityResult{Periods: periods})
+}
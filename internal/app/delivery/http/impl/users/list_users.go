@@ -0,0 +1,61 @@
+package users
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/delivery/http/impl/query"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+)
+
+// ListUsers lists users
+//
+//	@Summary		Получить список пользователей
+//	@Description	Пользователи упорядочены по времени создания; следующая страница запрашивается по next_cursor.
+//	@Tags			Users
+//	@Produce		json
+//	@Param			is_active	query		bool	false	"Только активные (или только неактивные) пользователи"
+//	@Param			team_name	query		string	false	"Команда, в которой состоит пользователь"
+//	@Param			cursor		query		string	false	"Курсор страницы (next_cursor предыдущего ответа)"
+//	@Param			limit		query		int		false	"Размер страницы (по умолчанию 50, не больше 200)"
+//	@Success		200			{object}	users.ListUsersResult
+//	@Failure		400			{object}	api.ContractError
+//	@Failure		404			{object}	api.ContractError
+//	@Failure		500			{object}	api.ContractError
+//	@Router			/users/list [get]
+func (h *Handler) ListUsers(c *fiber.Ctx) error {
+	params := usecase.ListUsersParams{
+		TeamName: c.Query("team_name"),
+		Cursor:   c.Query("cursor"),
+	}
+
+	var err error
+
+	if params.IsActive, err = query.Bool(c, "is_active"); err != nil {
+		return err
+	}
+
+	if params.Limit, err = query.Int(c, "limit"); err != nil {
+		return err
+	}
+
+	result, err := h.useCase.ListUsers(c.Context(), params)
+	if err != nil {
+		return err
+	}
+
+	usersResult := make([]users.ListUsersResultUser, 0, len(result.Users))
+	for _, user := range result.Users {
+		usersResult = append(usersResult, users.ListUsersResultUser{
+			UserID:   user.UserID,
+			Username: user.UserName,
+			Email:    user.Email,
+			IsActive: user.IsActive,
+		})
+	}
+
+	return c.JSON(users.ListUsersResult{
+		Users:      usersResult,
+		NextCursor: result.NextCursor,
+	})
+}
@@ -0,0 +1,48 @@
+package users
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// RemoveUserTeam removes user from team
+//
+//	@Summary	Исключить пользователя из команды (открытые ревью из этой команды передаются коллегам)
+//	@Tags		Users
+//	@Produce	json
+//	@Param		body	body		users.RemoveUserTeamParams	true	"users.RemoveUserTeamParams"
+//	@Success	200		{object}	users.RemoveUserTeamResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/users/removeTeam [post]
+func (h *Handler) RemoveUserTeam(c *fiber.Ctx) error {
+	var request users.RemoveUserTeamParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	result, err := h.useCase.RemoveUserTeam(c.Context(), usecase.RemoveUserTeamParams{
+		UserID:   request.UserID,
+		TeamName: request.TeamName,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = c.JSON(users.RemoveUserTeamResult{
+		User:                    toUserTeamsResultUser(result.User, result.Teams),
+		ReassignedPullRequests:  toReassignments(result.HandOff),
+		NoCandidatePullRequests: toNoCandidate(result.HandOff),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
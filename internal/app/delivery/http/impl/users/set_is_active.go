@@ -10,7 +10,7 @@ import (
 
 // SetIsActive gets pending for review PRs of user
 //
-//	@Summary	Установить флаг активности пользователя
+//	@Summary	Установить флаг активности пользователя (при деактивации открытые ревью передаются коллегам)
 //	@Tags		Users
 //	@Produce	json
 //	@Param		body	body		users.SetIsActiveParams	true	"users.SetIsActiveParams"
@@ -42,6 +42,8 @@ func (h *Handler) SetIsActive(c *fiber.Ctx) error {
 			TeamName: result.User.TeamName,
 			IsActive: result.User.IsActive,
 		},
+		ReassignedPullRequests:  toReassignments(result.HandOff),
+		NoCandidatePullRequests: toNoCandidate(result.HandOff),
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,58 @@
+package users
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// SetUserSchedule sets user time zone and working hours
+//
+//	@Summary		Задать часовой пояс и рабочие часы пользователя
+//	@Description	График заменяется целиком. Если в конфигурации включен учет рабочих часов, при автоматическом назначении сначала выбираются ревьюверы в рабочих часах, затем - те, чьи рабочие часы начнутся раньше. Пользователь без рабочих часов считается доступным в любое время.
+//	@Tags			Users
+//	@Produce		json
+//	@Param			body	body		users.SetUserScheduleParams	true	"users.SetUserScheduleParams"
+//	@Success		200		{object}	users.SetUserScheduleResult
+//	@Failure		400		{object}	api.ContractError
+//	@Failure		404		{object}	api.ContractError
+//	@Failure		500		{object}	api.ContractError
+//	@Router			/users/schedule/set [post]
+func (h *Handler) SetUserSchedule(c *fiber.Ctx) error {
+	var request users.SetUserScheduleParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.UserID == "" {
+		return errors.New(api.ErrUserIDNotProvided)
+	}
+
+	workingHours := make([]model.WorkingHours, 0, len(request.WorkingHours))
+	for _, interval := range request.WorkingHours {
+		workingHours = append(workingHours, model.WorkingHours{
+			Weekday: interval.Weekday,
+			Start:   interval.Start,
+			End:     interval.End,
+		})
+	}
+
+	result, err := h.useCase.SetUserSchedule(c.Context(), usecase.SetUserScheduleParams{
+		UserID:       request.UserID,
+		Timezone:     request.Timezone,
+		WorkingHours: workingHours,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(users.SetUserScheduleResult{
+		Schedule: toUserScheduleResult(result.Schedule),
+	})
+}
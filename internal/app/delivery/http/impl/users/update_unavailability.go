@@ -0,0 +1,51 @@
+package users
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// UpdateUnavailability updates user unavailability period
+//
+//	@Summary	Изменить границы или причину периода недоступности пользователя
+//	@Tags		Users
+//	@Produce	json
+//	@Param		body	body		users.UpdateUnavailabilityParams	true	"users.UpdateUnavailabilityParams"
+//	@Success	200		{object}	users.UpdateUnavailabilityResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/users/availability/update [post]
+func (h *Handler) UpdateUnavailability(c *fiber.Ctx) error {
+	var request users.UpdateUnavailabilityParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.PeriodID == "" {
+		return errors.New(api.ErrPeriodIDNotProvided)
+	}
+
+	result, err := h.useCase.UpdateUnavailabilityPeriod(
+		c.Context(),
+		usecase.UpdateUnavailabilityPeriodParams{
+			PeriodID: request.PeriodID,
+			StartsAt: request.StartsAt,
+			EndsAt:   request.EndsAt,
+			Reason:   request.Reason,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(users.UpdateUnavailabilityResult{
+		Period: toUnavailabilityPeriodResult(result.Period),
+	})
+}
@@ -0,0 +1,50 @@
+package users
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
+	"pr-reviewer-assign-service/internal/app/domain/usecase"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+// UpdateUser updates user attributes
+//
+//	@Summary	Изменить внешний ID, имя, email или лимит назначений пользователя
+//	@Tags		Users
+//	@Produce	json
+//	@Param		body	body		users.UpdateUserParams	true	"users.UpdateUserParams"
+//	@Success	200		{object}	users.UpdateUserResult
+//	@Failure	400		{object}	api.ContractError
+//	@Failure	404		{object}	api.ContractError
+//	@Failure	409		{object}	api.ContractError
+//	@Failure	500		{object}	api.ContractError
+//	@Router		/users/update [post]
+func (h *Handler) UpdateUser(c *fiber.Ctx) error {
+	var request users.UpdateUserParams
+
+	err := c.BodyParser(&request)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError)
+	}
+
+	if request.UserID == "" {
+		return errors.New(api.ErrUserIDNotProvided)
+	}
+
+	result, err := h.useCase.UpdateUser(c.Context(), usecase.UpdateUserParams{
+		UserID:         request.UserID,
+		NewUserID:      request.NewUserID,
+		Username:       request.Username,
+		Email:          request.Email,
+		MaxOpenReviews: request.MaxOpenReviews,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(users.UpdateUserResult{
+		User: toUserTeamsResultUser(result.User, result.Teams),
+	})
+}
@@ -0,0 +1,71 @@
+package actor
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Actor - инициатор запроса.
+type Actor struct {
+	// UserID - внешний ID пользователя, выполняющего запрос. Пустой, если инициатор не передан.
+	UserID string
+	// Reason - причина изменения в свободной форме, указанная инициатором.
+	Reason string
+	// HeaderTrusted - ID пользователя, переданный без токена, можно принимать
+	// (токены не заданы или включен auth.trust_actor_header).
+	HeaderTrusted bool
+
+	resolved *resolution
+}
+
+// resolution - внутренний ID инициатора, найденный один раз за запрос.
+type resolution struct {
+	once sync.Once
+	id   uuid.UUID
+	err  error
+}
+
+// New возвращает инициатора запроса, внутренний ID которого ищется не более одного раза.
+func New(userID, reason string, headerTrusted bool) Actor {
+	return Actor{
+		UserID:        userID,
+		Reason:        reason,
+		HeaderTrusted: headerTrusted,
+		resolved:      &resolution{},
+	}
+}
+
+// Resolve возвращает внутренний ID инициатора. resolve вызывается только при первом обращении,
+// последующие получают сохраненный результат.
+func (a Actor) Resolve(resolve func(userID string) (uuid.UUID, error)) (uuid.UUID, error) {
+	if a.resolved == nil {
+		return resolve(a.UserID)
+	}
+
+	a.resolved.once.Do(func() {
+		a.resolved.id, a.resolved.err = resolve(a.UserID)
+	})
+
+	return a.resolved.id, a.resolved.err
+}
+
+type ctxActor struct{}
+
+// FromCtx возвращает инициатора запроса, если контекст принадлежит HTTP-запросу.
+func FromCtx(ctx context.Context) (Actor, bool) {
+	a, ok := ctx.Value(ctxActor{}).(Actor)
+
+	return a, ok
+}
+
+func WithActor(ctx context.Context, a Actor) context.Context {
+	return context.WithValue(ctx, ctxActor{}, a)
+}
+
+// SetActor сохраняет инициатора через произвольное хранилище значений контекста
+// (например, fasthttp.RequestCtx.SetUserValue).
+func SetActor(a Actor, f func(key, value any)) {
+	f(ctxActor{}, a)
+}
@@ -0,0 +1,239 @@
+package ical
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	// Часовые пояса TZID разбираются и в образах без системной базы часовых поясов.
+	_ "time/tzdata"
+)
+
+// Event - событие календаря (VEVENT) с данными, нужными для импорта недоступности.
+type Event struct {
+	UID     string
+	Summary string
+	Start   time.Time
+	// End - конец события (не включительно).
+	End time.Time
+	// Organizer - email организатора (пусто, если не указан).
+	Organizer string
+	Attendees []Attendee
+	// Cancelled - событие отменено (STATUS:CANCELLED).
+	Cancelled bool
+	// OutOfOffice - событие помечено как отсутствие на работе.
+	OutOfOffice bool
+	// RecurrenceOverride - событие переопределяет одно из повторений другого события (RECURRENCE-ID).
+	RecurrenceOverride bool
+}
+
+// Attendee - участник события.
+type Attendee struct {
+	Email string
+	// Declined - участник отклонил приглашение (PARTSTAT=DECLINED).
+	Declined bool
+}
+
+// outOfOfficeCategories - категории (CATEGORIES), которыми календари помечают отсутствие.
+var outOfOfficeCategories = []string{"OUT-OF-OFFICE", "OUT OF OFFICE", "OOF", "OOO", "VACATION"}
+
+// Events возвращает события (VEVENT) из компонентов VCALENDAR.
+// Некорректные события, в том числе с неизвестным часовым поясом, пропускаются,
+// их количество возвращается в invalid.
+func Events(calendars []Component) (events []Event, invalid int) {
+	for _, calendar := range calendars {
+		timezones := ParseTimezones(calendar)
+
+		for _, component := range calendar.Components {
+			if component.Name != "VEVENT" {
+				continue
+			}
+
+			event, err := ParseEvent(component, timezones)
+			if err != nil {
+				invalid++
+
+				continue
+			}
+
+			events = append(events, event)
+		}
+	}
+
+	return events, invalid
+}
+
+// ParseEvent разбирает компонент VEVENT. TZID дат ищутся в том числе среди timezones.
+func ParseEvent(component Component, timezones Timezones) (Event, error) {
+	event := Event{
+		UID:                strings.TrimSpace(component.Value("UID")),
+		Summary:            unescapeText(component.Value("SUMMARY")),
+		Organizer:          mailtoAddress(component.Value("ORGANIZER")),
+		Cancelled:          strings.EqualFold(component.Value("STATUS"), "CANCELLED"),
+		OutOfOffice:        isOutOfOffice(component),
+		RecurrenceOverride: component.Value("RECURRENCE-ID") != "",
+	}
+
+	if event.UID == "" {
+		return Event{}, fmt.Errorf("event has no UID")
+	}
+
+	startProp, ok := component.Property("DTSTART")
+	if !ok {
+		return Event{}, fmt.Errorf("event %s has no DTSTART", event.UID)
+	}
+
+	start, allDay, err := parseDateTime(startProp, timezones)
+	if err != nil {
+		return Event{}, fmt.Errorf("event %s: %w", event.UID, err)
+	}
+
+	event.Start = start
+
+	if endProp, ok := component.Property("DTEND"); ok {
+		event.End, _, err = parseDateTime(endProp, timezones)
+		if err != nil {
+			return Event{}, fmt.Errorf("event %s: %w", event.UID, err)
+		}
+	} else if duration := component.Value("DURATION"); duration != "" {
+		d, err := parseDuration(duration)
+		if err != nil {
+			return Event{}, fmt.Errorf("event %s: %w", event.UID, err)
+		}
+
+		event.End = start.Add(d)
+	} else if allDay {
+		event.End = start.AddDate(0, 0, 1)
+	}
+
+	if !event.End.After(event.Start) {
+		return Event{}, fmt.Errorf("event %s ends before it starts", event.UID)
+	}
+
+	for _, prop := range component.PropertiesByName("ATTENDEE") {
+		email := mailtoAddress(prop.Value)
+		if email == "" {
+			continue
+		}
+
+		event.Attendees = append(event.Attendees, Attendee{
+			Email:    email,
+			Declined: strings.EqualFold(prop.Params["PARTSTAT"], "DECLINED"),
+		})
+	}
+
+	return event, nil
+}
+
+// isOutOfOffice проверяет признаки отсутствия: статус занятости Outlook (OOF) или категорию события.
+func isOutOfOffice(component Component) bool {
+	for _, name := range []string{"X-MICROSOFT-CDO-BUSYSTATUS", "X-MICROSOFT-CDO-INTENDEDSTATUS"} {
+		if strings.EqualFold(component.Value(name), "OOF") {
+			return true
+		}
+	}
+
+	for _, prop := range component.PropertiesByName("CATEGORIES") {
+		for _, category := range strings.Split(prop.Value, ",") {
+			if slices.Contains(outOfOfficeCategories, strings.ToUpper(strings.TrimSpace(category))) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// parseDateTime разбирает значение DATE или DATE-TIME. Время без часового пояса (floating)
+// считается временем UTC, время в часовом поясе TZID, который не удается определить, - ошибка.
+// allDay означает, что значение - дата без времени.
+func parseDateTime(prop Property, timezones Timezones) (t time.Time, allDay bool, err error) {
+	value := strings.TrimSpace(prop.Value)
+
+	if strings.EqualFold(prop.Params["VALUE"], "DATE") || len(value) == len("20060102") {
+		t, err = time.ParseInLocation("20060102", value, time.UTC)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid date %q", value)
+		}
+
+		return t, true, nil
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err = time.Parse("20060102T150405Z", value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid date-time %q", value)
+		}
+
+		return t, false, nil
+	}
+
+	t, err = time.ParseInLocation("20060102T150405", value, time.UTC)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid date-time %q", value)
+	}
+
+	if tzid := prop.Params["TZID"]; tzid != "" {
+		t, err = timezones.resolve(tzid, t)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+	}
+
+	return t, false, nil
+}
+
+var durationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseDuration разбирает длительность вида P1W, P2D, PT1H30M.
+func parseDuration(value string) (time.Duration, error) {
+	match := durationPattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(value)))
+	if match == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+	var d time.Duration
+
+	for i, unit := range units {
+		if match[i+2] == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(match[i+2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+
+		d += time.Duration(n) * unit
+	}
+
+	if match[1] == "-" {
+		d = -d
+	}
+
+	return d, nil
+}
+
+// mailtoAddress возвращает email из значения вида mailto:user@example.com в нижнем регистре.
+func mailtoAddress(value string) string {
+	value = strings.TrimSpace(value)
+
+	if len(value) >= len("mailto:") && strings.EqualFold(value[:len("mailto:")], "mailto:") {
+		value = value[len("mailto:"):]
+	}
+
+	if !strings.Contains(value, "@") {
+		return ""
+	}
+
+	return strings.ToLower(value)
+}
+
+// unescapeText снимает экранирование значения типа TEXT.
+func unescapeText(value string) string {
+	return strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
@@ -0,0 +1,246 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDateTime(t *testing.T) {
+	timezones := Timezones{
+		"Custom Time": {observances: []observance{
+			{start: time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), offsetFrom: 5 * time.Hour, offsetTo: 5 * time.Hour},
+		}},
+	}
+
+	tests := []struct {
+		name       string
+		prop       Property
+		want       time.Time
+		wantAllDay bool
+		wantErr    bool
+	}{
+		{
+			name:       "date",
+			prop:       Property{Params: map[string]string{"VALUE": "DATE"}, Value: "20250301"},
+			want:       time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantAllDay: true,
+		},
+		{
+			name:       "date without value param",
+			prop:       Property{Params: map[string]string{}, Value: "20250301"},
+			want:       time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantAllDay: true,
+		},
+		{
+			name: "utc",
+			prop: Property{Params: map[string]string{}, Value: "20250301T093000Z"},
+			want: time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "floating",
+			prop: Property{Params: map[string]string{}, Value: "20250301T093000"},
+			want: time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "iana tzid",
+			prop: Property{Params: map[string]string{"TZID": "Europe/Moscow"}, Value: "20250301T093000"},
+			want: time.Date(2025, 3, 1, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "iana tzid with dst",
+			prop: Property{Params: map[string]string{"TZID": "Europe/Berlin"}, Value: "20250701T093000"},
+			want: time.Date(2025, 7, 1, 7, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "windows tzid",
+			prop: Property{Params: map[string]string{"TZID": "Russian Standard Time"}, Value: "20250301T093000"},
+			want: time.Date(2025, 3, 1, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "vtimezone tzid",
+			prop: Property{Params: map[string]string{"TZID": "Custom Time"}, Value: "20250301T093000"},
+			want: time.Date(2025, 3, 1, 4, 30, 0, 0, time.UTC),
+		},
+		{
+			name:    "unknown tzid",
+			prop:    Property{Params: map[string]string{"TZID": "Unknown Standard Time"}, Value: "20250301T093000"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid date",
+			prop:    Property{Params: map[string]string{"VALUE": "DATE"}, Value: "2025-03-01"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid date-time",
+			prop:    Property{Params: map[string]string{}, Value: "20250301T0930Z"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, allDay, err := parseDateTime(tt.prop, timezones)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %s, want %s", got, tt.want)
+			assert.Equal(t, tt.wantAllDay, allDay)
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "P1W", want: 7 * 24 * time.Hour},
+		{value: "P2D", want: 48 * time.Hour},
+		{value: "PT1H30M", want: 90 * time.Minute},
+		{value: "P1DT2H", want: 26 * time.Hour},
+		{value: "PT45S", want: 45 * time.Second},
+		{value: "-PT15M", want: -15 * time.Minute},
+		{value: "+P1D", want: 24 * time.Hour},
+		{value: "p1d", want: 24 * time.Hour},
+		{value: "P", wantErr: true},
+		{value: "PT", wantErr: true},
+		{value: "1D", wantErr: true},
+		{value: "P1H", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseDuration(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMailtoAddress(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "mailto:john@example.com", want: "john@example.com"},
+		{value: "MAILTO:John.Doe@Example.com", want: "john.doe@example.com"},
+		{value: " mailto:john@example.com ", want: "john@example.com"},
+		{value: "john@example.com", want: "john@example.com"},
+		{value: "mailto:john", want: ""},
+		{value: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, mailtoAddress(tt.value))
+		})
+	}
+}
+
+func TestIsOutOfOffice(t *testing.T) {
+	tests := []struct {
+		name  string
+		props []Property
+		want  bool
+	}{
+		{name: "no markers", want: false},
+		{name: "outlook busy status", props: []Property{{Name: "X-MICROSOFT-CDO-BUSYSTATUS", Value: "OOF"}}, want: true},
+		{name: "outlook intended status", props: []Property{{Name: "X-MICROSOFT-CDO-INTENDEDSTATUS", Value: "oof"}}, want: true},
+		{name: "outlook busy", props: []Property{{Name: "X-MICROSOFT-CDO-BUSYSTATUS", Value: "BUSY"}}, want: false},
+		{name: "category", props: []Property{{Name: "CATEGORIES", Value: "Work, Vacation"}}, want: true},
+		{name: "category with spaces", props: []Property{{Name: "CATEGORIES", Value: "out of office"}}, want: true},
+		{
+			name:  "second categories property",
+			props: []Property{{Name: "CATEGORIES", Value: "Work"}, {Name: "CATEGORIES", Value: "OOO"}},
+			want:  true,
+		},
+		{name: "other category", props: []Property{{Name: "CATEGORIES", Value: "Meeting,Work"}}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isOutOfOffice(Component{Name: "VEVENT", Properties: tt.props}))
+		})
+	}
+}
+
+func TestEvents(t *testing.T) {
+	input := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"UID:all-day",
+		"SUMMARY:Vacation\\, Sochi",
+		"DTSTART;VALUE=DATE:20250301",
+		"CATEGORIES:Vacation",
+		"ORGANIZER;CN=John:mailto:John@example.com",
+		"ATTENDEE;PARTSTAT=ACCEPTED:mailto:anna@example.com",
+		"ATTENDEE;PARTSTAT=DECLINED:mailto:oleg@example.com",
+		"ATTENDEE:invalid",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:duration",
+		"DTSTART:20250301T090000Z",
+		"DURATION:PT2H",
+		"STATUS:CANCELLED",
+		"RECURRENCE-ID:20250301T090000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:unknown-zone",
+		"DTSTART;TZID=Unknown Standard Time:20250301T090000",
+		"DTEND;TZID=Unknown Standard Time:20250301T100000",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:ends-before-start",
+		"DTSTART:20250301T090000Z",
+		"DTEND:20250301T080000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART:20250301T090000Z",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	calendars, err := Parse(strings.NewReader(input))
+	require.NoError(t, err)
+
+	events, invalid := Events(calendars)
+	assert.Equal(t, 3, invalid)
+	require.Len(t, events, 2)
+
+	allDay := events[0]
+	assert.Equal(t, "all-day", allDay.UID)
+	assert.Equal(t, "Vacation, Sochi", allDay.Summary)
+	assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), allDay.Start)
+	assert.Equal(t, time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC), allDay.End)
+	assert.Equal(t, "john@example.com", allDay.Organizer)
+	assert.Equal(t, []Attendee{
+		{Email: "anna@example.com"},
+		{Email: "oleg@example.com", Declined: true},
+	}, allDay.Attendees)
+	assert.True(t, allDay.OutOfOffice)
+	assert.False(t, allDay.Cancelled)
+	assert.False(t, allDay.RecurrenceOverride)
+
+	withDuration := events[1]
+	assert.Equal(t, "duration", withDuration.UID)
+	assert.Equal(t, withDuration.Start.Add(2*time.Hour), withDuration.End)
+	assert.True(t, withDuration.Cancelled)
+	assert.True(t, withDuration.RecurrenceOverride)
+	assert.False(t, withDuration.OutOfOffice)
+}
@@ -0,0 +1,196 @@
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Property - свойство компонента iCalendar (RFC 5545, раздел 3.1).
+type Property struct {
+	Name string
+	// Params - параметры свойства; имена приведены к верхнему регистру.
+	Params map[string]string
+	Value  string
+}
+
+// Component - компонент iCalendar (VCALENDAR, VEVENT, ...) со свойствами и вложенными компонентами.
+type Component struct {
+	Name       string
+	Properties []Property
+	Components []Component
+}
+
+// Property возвращает первое свойство с указанным именем.
+func (c Component) Property(name string) (Property, bool) {
+	for _, prop := range c.Properties {
+		if prop.Name == name {
+			return prop, true
+		}
+	}
+
+	return Property{}, false
+}
+
+// Value возвращает значение первого свойства с указанным именем (пустое, если свойства нет).
+func (c Component) Value(name string) string {
+	prop, _ := c.Property(name)
+
+	return prop.Value
+}
+
+// PropertiesByName возвращает все свойства с указанным именем.
+func (c Component) PropertiesByName(name string) []Property {
+	var props []Property
+
+	for _, prop := range c.Properties {
+		if prop.Name == name {
+			props = append(props, prop)
+		}
+	}
+
+	return props
+}
+
+// Parse читает документ iCalendar и возвращает его компоненты верхнего уровня.
+func Parse(r io.Reader) ([]Component, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		roots []Component
+		stack []Component
+	)
+
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		prop, err := parseProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		switch prop.Name {
+		case "BEGIN":
+			stack = append(stack, Component{Name: strings.ToUpper(prop.Value)})
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].Name != strings.ToUpper(prop.Value) {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", i+1, prop.Value)
+			}
+
+			component := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if len(stack) == 0 {
+				roots = append(roots, component)
+			} else {
+				parent := &stack[len(stack)-1]
+				parent.Components = append(parent.Components, component)
+			}
+		default:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("line %d: property %s outside of component", i+1, prop.Name)
+			}
+
+			current := &stack[len(stack)-1]
+			current.Properties = append(current.Properties, prop)
+		}
+	}
+
+	if len(stack) != 0 {
+		return nil, fmt.Errorf("component %s is not closed", stack[len(stack)-1].Name)
+	}
+
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("no components found")
+	}
+
+	return roots, nil
+}
+
+// unfoldLines разбивает документ на логические строки, склеивая перенесенные
+// (строки продолжения начинаются с пробела или табуляции).
+func unfoldLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading calendar: %w", err)
+	}
+
+	return lines, nil
+}
+
+// parseProperty разбирает строку вида NAME;PARAM=VALUE;PARAM="VALUE":VALUE.
+func parseProperty(line string) (Property, error) {
+	prop := Property{Params: make(map[string]string)}
+
+	nameEnd := strings.IndexAny(line, ";:")
+	if nameEnd <= 0 {
+		return Property{}, fmt.Errorf("invalid content line %q", line)
+	}
+
+	prop.Name = strings.ToUpper(line[:nameEnd])
+	rest := line[nameEnd:]
+
+	for strings.HasPrefix(rest, ";") {
+		rest = rest[1:]
+
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return Property{}, fmt.Errorf("invalid parameter in line %q", line)
+		}
+
+		paramName := strings.ToUpper(rest[:eq])
+		rest = rest[eq+1:]
+
+		var paramValue string
+
+		if strings.HasPrefix(rest, `"`) {
+			closing := strings.IndexByte(rest[1:], '"')
+			if closing < 0 {
+				return Property{}, fmt.Errorf("unterminated quoted parameter in line %q", line)
+			}
+
+			paramValue = rest[1 : closing+1]
+			rest = rest[closing+2:]
+		} else {
+			end := strings.IndexAny(rest, ";:")
+			if end < 0 {
+				return Property{}, fmt.Errorf("invalid parameter in line %q", line)
+			}
+
+			paramValue = rest[:end]
+			rest = rest[end:]
+		}
+
+		prop.Params[paramName] = paramValue
+	}
+
+	if !strings.HasPrefix(rest, ":") {
+		return Property{}, fmt.Errorf("missing value in line %q", line)
+	}
+
+	prop.Value = rest[1:]
+
+	return prop, nil
+}
@@ -0,0 +1,150 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnfoldLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "crlf",
+			input: "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n",
+			want:  []string{"BEGIN:VCALENDAR", "END:VCALENDAR"},
+		},
+		{
+			name:  "space continuation",
+			input: "SUMMARY:Long\r\n  summary\r\n",
+			want:  []string{"SUMMARY:Long summary"},
+		},
+		{
+			name:  "tab continuation",
+			input: "SUMMARY:Va\n\tcation\n",
+			want:  []string{"SUMMARY:Vacation"},
+		},
+		{
+			name:  "continuation splits parameter",
+			input: "ATTENDEE;PARTSTAT=DEC\r\n LINED:mailto:a@example.com\r\n",
+			want:  []string{"ATTENDEE;PARTSTAT=DECLINED:mailto:a@example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := unfoldLines(strings.NewReader(tt.input))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, lines)
+		})
+	}
+}
+
+func TestParseProperty(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    Property
+		wantErr bool
+	}{
+		{
+			name: "plain",
+			line: "summary:Vacation",
+			want: Property{Name: "SUMMARY", Params: map[string]string{}, Value: "Vacation"},
+		},
+		{
+			name: "params",
+			line: "DTSTART;tzid=Europe/Moscow;VALUE=DATE-TIME:20250101T090000",
+			want: Property{
+				Name:   "DTSTART",
+				Params: map[string]string{"TZID": "Europe/Moscow", "VALUE": "DATE-TIME"},
+				Value:  "20250101T090000",
+			},
+		},
+		{
+			name: "quoted param with separators",
+			line: `ATTENDEE;CN="Doe; John: QA";PARTSTAT=ACCEPTED:mailto:john@example.com`,
+			want: Property{
+				Name:   "ATTENDEE",
+				Params: map[string]string{"CN": "Doe; John: QA", "PARTSTAT": "ACCEPTED"},
+				Value:  "mailto:john@example.com",
+			},
+		},
+		{
+			name: "value with colons",
+			line: "DESCRIPTION:see https://example.com",
+			want: Property{Name: "DESCRIPTION", Params: map[string]string{}, Value: "see https://example.com"},
+		},
+		{name: "no value", line: "SUMMARY", wantErr: true},
+		{name: "no name", line: ":value", wantErr: true},
+		{name: "unterminated quote", line: `ATTENDEE;CN="Doe:mailto:a@example.com`, wantErr: true},
+		{name: "param without value", line: "DTSTART;TZID:20250101T090000", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prop, err := parseProperty(tt.line)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, prop)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	input := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"UID:1",
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"END:VALARM",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	calendars, err := Parse(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, calendars, 1)
+
+	calendar := calendars[0]
+	assert.Equal(t, "VCALENDAR", calendar.Name)
+	assert.Equal(t, "2.0", calendar.Value("VERSION"))
+	require.Len(t, calendar.Components, 1)
+
+	event := calendar.Components[0]
+	assert.Equal(t, "VEVENT", event.Name)
+	assert.Equal(t, "1", event.Value("UID"))
+	require.Len(t, event.Components, 1)
+	assert.Equal(t, "VALARM", event.Components[0].Name)
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty", input: ""},
+		{name: "not closed", input: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nEND:VEVENT\r\n"},
+		{name: "mismatched end", input: "BEGIN:VCALENDAR\r\nEND:VEVENT\r\n"},
+		{name: "property outside component", input: "UID:1\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,443 @@
+package ical
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Timezones - часовые пояса, описанные компонентами VTIMEZONE календаря, по TZID.
+type Timezones map[string]Timezone
+
+// Timezone - часовой пояс из VTIMEZONE: набор правил перехода (STANDARD и DAYLIGHT).
+type Timezone struct {
+	observances []observance
+}
+
+// observance - правило STANDARD или DAYLIGHT: с момента start (и далее ежегодно по rule,
+// если оно задано) действует смещение offsetTo.
+type observance struct {
+	// start - DTSTART правила в местном времени (как время UTC).
+	start      time.Time
+	offsetFrom time.Duration
+	offsetTo   time.Duration
+	rule       *yearlyRule
+}
+
+// yearlyRule - ежегодное повторение перехода RRULE:FREQ=YEARLY;BYMONTH=m;BYDAY=nDD,
+// которое Outlook и другие календари используют в VTIMEZONE.
+type yearlyRule struct {
+	month   time.Month
+	week    int
+	weekday time.Weekday
+	// until - последнее повторение в местном времени (нулевое - без ограничения).
+	until time.Time
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// ParseTimezones разбирает компоненты VTIMEZONE календаря.
+// Часовые пояса с правилами, которые не удается разобрать, пропускаются.
+func ParseTimezones(calendar Component) Timezones {
+	timezones := make(Timezones)
+
+	for _, component := range calendar.Components {
+		if component.Name != "VTIMEZONE" {
+			continue
+		}
+
+		tzid := strings.TrimSpace(component.Value("TZID"))
+		if tzid == "" {
+			continue
+		}
+
+		tz, err := parseTimezone(component)
+		if err != nil {
+			continue
+		}
+
+		timezones[tzid] = tz
+	}
+
+	return timezones
+}
+
+func parseTimezone(component Component) (Timezone, error) {
+	var tz Timezone
+
+	for _, child := range component.Components {
+		if child.Name != "STANDARD" && child.Name != "DAYLIGHT" {
+			continue
+		}
+
+		o, err := parseObservance(child)
+		if err != nil {
+			return Timezone{}, err
+		}
+
+		tz.observances = append(tz.observances, o)
+	}
+
+	if len(tz.observances) == 0 {
+		return Timezone{}, fmt.Errorf("time zone has no STANDARD or DAYLIGHT rules")
+	}
+
+	return tz, nil
+}
+
+func parseObservance(component Component) (observance, error) {
+	var (
+		o   observance
+		err error
+	)
+
+	o.start, err = time.ParseInLocation("20060102T150405", strings.TrimSpace(component.Value("DTSTART")), time.UTC)
+	if err != nil {
+		return observance{}, fmt.Errorf("invalid %s DTSTART", component.Name)
+	}
+
+	o.offsetFrom, err = parseUTCOffset(component.Value("TZOFFSETFROM"))
+	if err != nil {
+		return observance{}, err
+	}
+
+	o.offsetTo, err = parseUTCOffset(component.Value("TZOFFSETTO"))
+	if err != nil {
+		return observance{}, err
+	}
+
+	if rrule := component.Value("RRULE"); rrule != "" {
+		o.rule, err = parseYearlyRule(rrule)
+		if err != nil {
+			return observance{}, err
+		}
+	}
+
+	return o, nil
+}
+
+// parseUTCOffset разбирает смещение вида +0300, -0500 или +053000.
+func parseUTCOffset(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+
+	if (len(value) != 5 && len(value) != 7) || (value[0] != '+' && value[0] != '-') {
+		return 0, fmt.Errorf("invalid UTC offset %q", value)
+	}
+
+	var parts [3]int
+
+	for i := 0; 1+2*i < len(value); i++ {
+		n, err := strconv.Atoi(value[1+2*i : 3+2*i])
+		if err != nil {
+			return 0, fmt.Errorf("invalid UTC offset %q", value)
+		}
+
+		parts[i] = n
+	}
+
+	offset := time.Duration(parts[0])*time.Hour + time.Duration(parts[1])*time.Minute +
+		time.Duration(parts[2])*time.Second
+
+	if value[0] == '-' {
+		offset = -offset
+	}
+
+	return offset, nil
+}
+
+// parseYearlyRule разбирает RRULE правила перехода. Поддерживается только ежегодное
+// повторение в n-й (или последний) день недели месяца.
+func parseYearlyRule(value string) (*yearlyRule, error) {
+	rule := &yearlyRule{}
+
+	var freq, byDay string
+
+	for _, part := range strings.Split(strings.ToUpper(strings.TrimSpace(value)), ";") {
+		key, val, _ := strings.Cut(part, "=")
+
+		switch key {
+		case "FREQ":
+			freq = val
+		case "BYMONTH":
+			month, err := strconv.Atoi(val)
+			if err != nil || month < 1 || month > 12 {
+				return nil, fmt.Errorf("invalid RRULE %q", value)
+			}
+
+			rule.month = time.Month(month)
+		case "BYDAY":
+			byDay = val
+		case "UNTIL":
+			until, err := time.ParseInLocation("20060102T150405", strings.TrimSuffix(val, "Z"), time.UTC)
+			if err != nil {
+				return nil, fmt.Errorf("invalid RRULE %q", value)
+			}
+
+			rule.until = until
+		case "WKST":
+		case "INTERVAL":
+			if val != "1" {
+				return nil, fmt.Errorf("unsupported RRULE %q", value)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported RRULE %q", value)
+		}
+	}
+
+	if freq != "YEARLY" || rule.month == 0 || len(byDay) < 3 {
+		return nil, fmt.Errorf("unsupported RRULE %q", value)
+	}
+
+	weekday, ok := weekdays[byDay[len(byDay)-2:]]
+	if !ok {
+		return nil, fmt.Errorf("invalid RRULE %q", value)
+	}
+
+	week, err := strconv.Atoi(byDay[:len(byDay)-2])
+	if err != nil || week == 0 || week < -5 || week > 5 {
+		return nil, fmt.Errorf("unsupported RRULE %q", value)
+	}
+
+	rule.week = week
+	rule.weekday = weekday
+
+	return rule, nil
+}
+
+// onset возвращает момент перехода в году year в местном времени с часами и минутами clock.
+// Пятая неделя, которой нет в месяце, означает последнюю.
+func (r *yearlyRule) onset(year int, clock time.Time) time.Time {
+	hour, minute, second := clock.Clock()
+
+	if r.week > 0 {
+		day := time.Date(year, r.month, 1, hour, minute, second, 0, time.UTC)
+		day = day.AddDate(0, 0, (int(r.weekday)-int(day.Weekday())+7)%7+(r.week-1)*7)
+
+		for day.Month() != r.month {
+			day = day.AddDate(0, 0, -7)
+		}
+
+		return day
+	}
+
+	day := time.Date(year, r.month+1, 0, hour, minute, second, 0, time.UTC)
+	day = day.AddDate(0, 0, -((int(day.Weekday())-int(r.weekday)+7)%7)+(r.week+1)*7)
+
+	for day.Month() != r.month {
+		day = day.AddDate(0, 0, 7)
+	}
+
+	return day
+}
+
+// lastOnset возвращает последний переход правила не позже местного времени wall.
+func (o observance) lastOnset(wall time.Time) (time.Time, bool) {
+	if wall.Before(o.start) {
+		return time.Time{}, false
+	}
+
+	if o.rule == nil {
+		return o.start, true
+	}
+
+	last := wall
+	if !o.rule.until.IsZero() && o.rule.until.Before(last) {
+		last = o.rule.until
+	}
+
+	for year := last.Year(); year >= o.start.Year() && year >= last.Year()-1; year-- {
+		onset := o.rule.onset(year, o.start)
+		if !onset.Before(o.start) && !onset.After(last) {
+			return onset, true
+		}
+	}
+
+	return o.start, true
+}
+
+// toUTC переводит местное время wall (заданное как время UTC) в UTC по правилам часового пояса.
+func (tz Timezone) toUTC(wall time.Time) time.Time {
+	var (
+		current   observance
+		currentAt time.Time
+		found     bool
+	)
+
+	for _, o := range tz.observances {
+		onset, ok := o.lastOnset(wall)
+		if ok && (!found || onset.After(currentAt)) {
+			current, currentAt, found = o, onset, true
+		}
+	}
+
+	if !found {
+		earliest := tz.observances[0]
+		for _, o := range tz.observances[1:] {
+			if o.start.Before(earliest.start) {
+				earliest = o
+			}
+		}
+
+		return wall.Add(-earliest.offsetFrom)
+	}
+
+	return wall.Add(-current.offsetTo)
+}
+
+// resolve переводит местное время wall в часовом поясе tzid в UTC. TZID ищется в базе IANA,
+// затем среди имен часовых поясов Windows (их использует Outlook), затем среди VTIMEZONE календаря.
+func (z Timezones) resolve(tzid string, wall time.Time) (time.Time, error) {
+	name := strings.TrimPrefix(strings.TrimSpace(tzid), "/")
+
+	if name != "" && name != "Local" {
+		if location, err := time.LoadLocation(name); err == nil {
+			return inLocation(wall, location), nil
+		}
+	}
+
+	if iana, ok := windowsZones[name]; ok {
+		if location, err := time.LoadLocation(iana); err == nil {
+			return inLocation(wall, location), nil
+		}
+	}
+
+	if tz, ok := z[tzid]; ok {
+		return tz.toUTC(wall), nil
+	}
+
+	return time.Time{}, fmt.Errorf("unknown time zone %q", tzid)
+}
+
+func inLocation(wall time.Time, location *time.Location) time.Time {
+	year, month, day := wall.Date()
+	hour, minute, second := wall.Clock()
+
+	return time.Date(year, month, day, hour, minute, second, 0, location).UTC()
+}
+
+// windowsZones сопоставляет имена часовых поясов Windows с зонами IANA (CLDR windowsZones, территория 001).
+var windowsZones = map[string]string{
+	"Dateline Standard Time":          "Etc/GMT+12",
+	"UTC-11":                          "Etc/GMT+11",
+	"Aleutian Standard Time":          "America/Adak",
+	"Hawaiian Standard Time":          "Pacific/Honolulu",
+	"Alaskan Standard Time":           "America/Anchorage",
+	"Pacific Standard Time (Mexico)":  "America/Tijuana",
+	"Pacific Standard Time":           "America/Los_Angeles",
+	"US Mountain Standard Time":       "America/Phoenix",
+	"Mountain Standard Time (Mexico)": "America/Mazatlan",
+	"Mountain Standard Time":          "America/Denver",
+	"Central America Standard Time":   "America/Guatemala",
+	"Central Standard Time":           "America/Chicago",
+	"Central Standard Time (Mexico)":  "America/Mexico_City",
+	"Canada Central Standard Time":    "America/Regina",
+	"SA Pacific Standard Time":        "America/Bogota",
+	"Eastern Standard Time (Mexico)":  "America/Cancun",
+	"Eastern Standard Time":           "America/New_York",
+	"US Eastern Standard Time":        "America/Indianapolis",
+	"Venezuela Standard Time":         "America/Caracas",
+	"Paraguay Standard Time":          "America/Asuncion",
+	"Atlantic Standard Time":          "America/Halifax",
+	"Central Brazilian Standard Time": "America/Cuiaba",
+	"SA Western Standard Time":        "America/La_Paz",
+	"Pacific SA Standard Time":        "America/Santiago",
+	"Newfoundland Standard Time":      "America/St_Johns",
+	"E. South America Standard Time":  "America/Sao_Paulo",
+	"SA Eastern Standard Time":        "America/Cayenne",
+	"Argentina Standard Time":         "America/Buenos_Aires",
+	"Greenland Standard Time":         "America/Godthab",
+	"Montevideo Standard Time":        "America/Montevideo",
+	"UTC-02":                          "Etc/GMT+2",
+	"Azores Standard Time":            "Atlantic/Azores",
+	"Cape Verde Standard Time":        "Atlantic/Cape_Verde",
+	"UTC":                             "Etc/UTC",
+	"GMT Standard Time":               "Europe/London",
+	"Greenwich Standard Time":         "Atlantic/Reykjavik",
+	"Morocco Standard Time":           "Africa/Casablanca",
+	"W. Europe Standard Time":         "Europe/Berlin",
+	"Central Europe Standard Time":    "Europe/Budapest",
+	"Romance Standard Time":           "Europe/Paris",
+	"Central European Standard Time":  "Europe/Warsaw",
+	"W. Central Africa Standard Time": "Africa/Lagos",
+	"Jordan Standard Time":            "Asia/Amman",
+	"GTB Standard Time":               "Europe/Bucharest",
+	"Middle East Standard Time":       "Asia/Beirut",
+	"Egypt Standard Time":             "Africa/Cairo",
+	"E. Europe Standard Time":         "Europe/Chisinau",
+	"Syria Standard Time":             "Asia/Damascus",
+	"South Africa Standard Time":      "Africa/Johannesburg",
+	"FLE Standard Time":               "Europe/Kiev",
+	"Israel Standard Time":            "Asia/Jerusalem",
+	"Kaliningrad Standard Time":       "Europe/Kaliningrad",
+	"Libya Standard Time":             "Africa/Tripoli",
+	"Arabic Standard Time":            "Asia/Baghdad",
+	"Turkey Standard Time":            "Europe/Istanbul",
+	"Arab Standard Time":              "Asia/Riyadh",
+	"Belarus Standard Time":           "Europe/Minsk",
+	"Russian Standard Time":           "Europe/Moscow",
+	"E. Africa Standard Time":         "Africa/Nairobi",
+	"Volgograd Standard Time":         "Europe/Volgograd",
+	"Iran Standard Time":              "Asia/Tehran",
+	"Arabian Standard Time":           "Asia/Dubai",
+	"Astrakhan Standard Time":         "Europe/Astrakhan",
+	"Azerbaijan Standard Time":        "Asia/Baku",
+	"Russia Time Zone 3":              "Europe/Samara",
+	"Mauritius Standard Time":         "Indian/Mauritius",
+	"Saratov Standard Time":           "Europe/Saratov",
+	"Georgian Standard Time":          "Asia/Tbilisi",
+	"Caucasus Standard Time":          "Asia/Yerevan",
+	"Afghanistan Standard Time":       "Asia/Kabul",
+	"West Asia Standard Time":         "Asia/Tashkent",
+	"Ekaterinburg Standard Time":      "Asia/Yekaterinburg",
+	"Pakistan Standard Time":          "Asia/Karachi",
+	"India Standard Time":             "Asia/Calcutta",
+	"Sri Lanka Standard Time":         "Asia/Colombo",
+	"Nepal Standard Time":             "Asia/Katmandu",
+	"Central Asia Standard Time":      "Asia/Almaty",
+	"Bangladesh Standard Time":        "Asia/Dhaka",
+	"Omsk Standard Time":              "Asia/Omsk",
+	"Myanmar Standard Time":           "Asia/Rangoon",
+	"SE Asia Standard Time":           "Asia/Bangkok",
+	"Altai Standard Time":             "Asia/Barnaul",
+	"W. Mongolia Standard Time":       "Asia/Hovd",
+	"North Asia Standard Time":        "Asia/Krasnoyarsk",
+	"N. Central Asia Standard Time":   "Asia/Novosibirsk",
+	"Tomsk Standard Time":             "Asia/Tomsk",
+	"China Standard Time":             "Asia/Shanghai",
+	"North Asia East Standard Time":   "Asia/Irkutsk",
+	"Singapore Standard Time":         "Asia/Singapore",
+	"W. Australia Standard Time":      "Australia/Perth",
+	"Taipei Standard Time":            "Asia/Taipei",
+	"Ulaanbaatar Standard Time":       "Asia/Ulaanbaatar",
+	"Transbaikal Standard Time":       "Asia/Chita",
+	"Tokyo Standard Time":             "Asia/Tokyo",
+	"Korea Standard Time":             "Asia/Seoul",
+	"Yakutsk Standard Time":           "Asia/Yakutsk",
+	"Cen. Australia Standard Time":    "Australia/Adelaide",
+	"AUS Central Standard Time":       "Australia/Darwin",
+	"E. Australia Standard Time":      "Australia/Brisbane",
+	"AUS Eastern Standard Time":       "Australia/Sydney",
+	"West Pacific Standard Time":      "Pacific/Port_Moresby",
+	"Tasmania Standard Time":          "Australia/Hobart",
+	"Vladivostok Standard Time":       "Asia/Vladivostok",
+	"Magadan Standard Time":           "Asia/Magadan",
+	"Sakhalin Standard Time":          "Asia/Sakhalin",
+	"Central Pacific Standard Time":   "Pacific/Guadalcanal",
+	"Russia Time Zone 10":             "Asia/Srednekolymsk",
+	"Russia Time Zone 11":             "Asia/Kamchatka",
+	"New Zealand Standard Time":       "Pacific/Auckland",
+	"UTC+12":                          "Etc/GMT-12",
+	"Fiji Standard Time":              "Pacific/Fiji",
+	"Tonga Standard Time":             "Pacific/Tongatapu",
+	"Samoa Standard Time":             "Pacific/Apia",
+	"Line Islands Standard Time":      "Pacific/Kiritimati",
+}
@@ -0,0 +1,179 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// outlookCalendar - календарь в формате Outlook: TZID с произвольным именем, правила перехода
+// на летнее время описаны в VTIMEZONE.
+const outlookCalendar = "BEGIN:VCALENDAR\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:Contoso Standard Time\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:16010101T030000\r\n" +
+	"TZOFFSETFROM:+0200\r\n" +
+	"TZOFFSETTO:+0100\r\n" +
+	"RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=10\r\n" +
+	"END:STANDARD\r\n" +
+	"BEGIN:DAYLIGHT\r\n" +
+	"DTSTART:16010101T020000\r\n" +
+	"TZOFFSETFROM:+0100\r\n" +
+	"TZOFFSETTO:+0200\r\n" +
+	"RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=3\r\n" +
+	"END:DAYLIGHT\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:Unsupported Time\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:16010101T030000\r\n" +
+	"TZOFFSETFROM:+0200\r\n" +
+	"TZOFFSETTO:+0100\r\n" +
+	"RRULE:FREQ=MONTHLY;BYMONTHDAY=1\r\n" +
+	"END:STANDARD\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:Fixed Time\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:16010101T000000\r\n" +
+	"TZOFFSETFROM:-0330\r\n" +
+	"TZOFFSETTO:-0330\r\n" +
+	"END:STANDARD\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseTimezones(t *testing.T) {
+	calendars, err := Parse(strings.NewReader(outlookCalendar))
+	require.NoError(t, err)
+	require.Len(t, calendars, 1)
+
+	timezones := ParseTimezones(calendars[0])
+	assert.Contains(t, timezones, "Contoso Standard Time")
+	assert.Contains(t, timezones, "Fixed Time")
+	assert.NotContains(t, timezones, "Unsupported Time")
+
+	tests := []struct {
+		name string
+		tzid string
+		wall time.Time
+		want time.Time
+	}{
+		{
+			name: "standard time",
+			tzid: "Contoso Standard Time",
+			wall: time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "daylight time",
+			tzid: "Contoso Standard Time",
+			wall: time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 7, 1, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "after daylight onset",
+			tzid: "Contoso Standard Time",
+			wall: time.Date(2025, 3, 30, 4, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 3, 30, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "before daylight onset",
+			tzid: "Contoso Standard Time",
+			wall: time.Date(2025, 3, 29, 10, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 3, 29, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "after standard onset",
+			tzid: "Contoso Standard Time",
+			wall: time.Date(2025, 10, 26, 10, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 10, 26, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "fixed offset",
+			tzid: "Fixed Time",
+			wall: time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 7, 1, 13, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := timezones.resolve(tt.tzid, tt.wall)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %s, want %s", got, tt.want)
+		})
+	}
+
+	_, err = timezones.resolve("Unsupported Time", time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC))
+	assert.Error(t, err)
+}
+
+func TestWindowsZones(t *testing.T) {
+	for windows, iana := range windowsZones {
+		_, err := time.LoadLocation(iana)
+		assert.NoError(t, err, windows)
+	}
+}
+
+func TestParseUTCOffset(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "+0300", want: 3 * time.Hour},
+		{value: "-0500", want: -5 * time.Hour},
+		{value: "+0530", want: 5*time.Hour + 30*time.Minute},
+		{value: "+053010", want: 5*time.Hour + 30*time.Minute + 10*time.Second},
+		{value: "0300", wantErr: true},
+		{value: "+03", wantErr: true},
+		{value: "+03a0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseUTCOffset(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestYearlyRuleOnset(t *testing.T) {
+	clock := time.Date(1601, 1, 1, 2, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		rrule string
+		year  int
+		want  time.Time
+	}{
+		{rrule: "FREQ=YEARLY;BYDAY=-1SU;BYMONTH=3", year: 2025, want: time.Date(2025, 3, 30, 2, 0, 0, 0, time.UTC)},
+		{rrule: "FREQ=YEARLY;BYDAY=-1SU;BYMONTH=10", year: 2024, want: time.Date(2024, 10, 27, 2, 0, 0, 0, time.UTC)},
+		{rrule: "FREQ=YEARLY;BYDAY=2SU;BYMONTH=3", year: 2025, want: time.Date(2025, 3, 9, 2, 0, 0, 0, time.UTC)},
+		{rrule: "FREQ=YEARLY;BYDAY=1SU;BYMONTH=11", year: 2025, want: time.Date(2025, 11, 2, 2, 0, 0, 0, time.UTC)},
+		{rrule: "FREQ=YEARLY;BYDAY=5SU;BYMONTH=2", year: 2025, want: time.Date(2025, 2, 23, 2, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rrule, func(t *testing.T) {
+			rule, err := parseYearlyRule(tt.rrule)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rule.onset(tt.year, clock))
+		})
+	}
+
+	for _, rrule := range []string{"FREQ=MONTHLY;BYDAY=1SU", "FREQ=YEARLY;BYMONTH=3", "FREQ=YEARLY;BYDAY=SU;BYMONTH=3"} {
+		_, err := parseYearlyRule(rrule)
+		assert.Error(t, err, rrule)
+	}
+}
@@ -1,27 +1,105 @@
 package model
 
+import "time"
+
 type TeamMember struct {
 	UserID   string
 	Username string
 	IsActive bool
+	Role     TeamMemberRole
 }
 
 type Team struct {
-	TeamName string
-	Members  []TeamMember
+	TeamName          string
+	ReviewerStrategy  string
+	RequiredReviewers int
+	// MaxOpenReviews - лимит назначений на открытые PR для участников (0 - без ограничений).
+	MaxOpenReviews int
+	// PreferWorkingHours - сначала выбираются ревьюверы в рабочих часах.
+	PreferWorkingHours bool
+	LeadRule           TeamLeadRule
+	// FallbackTeams - резервные команды в порядке обращения к ним.
+	FallbackTeams []string
+	Members       []TeamMember
 }
 
 type User struct {
 	UserID   string
 	UserName string
+	Email    string
 	IsActive bool
 	TeamName string
 }
 
+// UserLoad - текущая нагрузка пользователя как ревьювера и его лимит назначений.
+type UserLoad struct {
+	UserID string
+	// OpenReviews - количество текущих назначений на открытые PR.
+	OpenReviews int64
+	// MaxOpenReviews - действующий лимит назначений (0 - без ограничений).
+	MaxOpenReviews int
+	// AtCapacity - лимит исчерпан, автоматически пользователь не назначается.
+	AtCapacity bool
+}
+
+// UnavailabilityPeriod - период, в который пользователь не назначается ревьювером.
+// UserSchedule - часовой пояс и рабочие часы пользователя.
+type UserSchedule struct {
+	UserID string
+	// Timezone - часовой пояс IANA (пусто - UTC).
+	Timezone     string
+	WorkingHours []WorkingHours
+	// InWorkingHours - пользователь сейчас в рабочих часах (всегда true, если рабочие часы не заданы).
+	InWorkingHours bool
+	// NextWorkingAt - начало ближайших рабочих часов (нулевое, если пользователь в рабочих часах).
+	NextWorkingAt time.Time
+}
+
+// WorkingHours - рабочий интервал в один из дней недели в часовом поясе пользователя.
+type WorkingHours struct {
+	// Weekday - день недели: monday, tuesday, ..., sunday.
+	Weekday string
+	// Start - начало интервала в формате HH:MM.
+	Start string
+	// End - конец интервала в формате HH:MM (не включительно, 24:00 - до конца дня).
+	End string
+}
+
+type UnavailabilityPeriod struct {
+	PeriodID string
+	UserID   string
+	StartsAt time.Time
+	// EndsAt - конец периода (не включительно).
+	EndsAt time.Time
+	Reason string
+	// HandedOff - открытые ревью пользователя уже переданы другим ревьюверам.
+	HandedOff bool
+	// ExternalUID - UID события календаря, из которого импортирован период.
+	ExternalUID string
+}
+
+// UserTeam - участие пользователя в команде.
+type UserTeam struct {
+	TeamName  string
+	Role      string
+	IsPrimary bool
+}
+
 type PullRequest struct {
 	PullRequestShort
 
 	AssignedReviewers []string
+	NeedMoreReviewers bool
+}
+
+// PullRequestDetails - PR с командой и временем создания и мержа.
+type PullRequestDetails struct {
+	PullRequest
+
+	TeamName  string
+	CreatedAt time.Time
+	// MergedAt - время мержа (нулевое, если PR не смержен).
+	MergedAt time.Time
 }
 
 type PullRequestShort struct {
@@ -31,11 +109,79 @@ type PullRequestShort struct {
 	Status          string
 }
 
+// ReviewAssignment - PR, на который пользователь назначен ревьювером.
+type ReviewAssignment struct {
+	PullRequestShort
+
+	AssignedAt time.Time
+}
+
+// PRReviewerHistoryEntry - запись истории изменения состава ревьюверов PR.
+type PRReviewerHistoryEntry struct {
+	// OldReviewerID - снятый ревьювер (пусто при назначении).
+	OldReviewerID string
+	// NewReviewerID - назначенный ревьювер (пусто при снятии без замены).
+	NewReviewerID string
+	// ChangedBy - инициатор изменения (пусто для системных изменений).
+	ChangedBy string
+	Reason    PRReviewerHistoryChangeReason
+	// Comment - причина изменения в свободной форме, указанная инициатором.
+	Comment   string
+	ChangedAt time.Time
+}
+
+// ReassignedReview описывает передачу ревью PR от одного ревьювера другому.
+type ReassignedReview struct {
+	PullRequestID string
+	OldReviewerID string
+	NewReviewerID string
+}
+
+type TeamMemberRole = string
+
+const (
+	TeamMemberRoleMember = "MEMBER"
+	TeamMemberRoleLead   = "LEAD"
+)
+
+// TeamLeadRule определяет, как роль LEAD учитывается при назначении ревьюверов.
+type TeamLeadRule = string
+
+const (
+	// TeamLeadRuleNone - роль не влияет на назначение.
+	TeamLeadRuleNone = "none"
+	// TeamLeadRuleRequire - среди ревьюверов команды должен быть хотя бы один LEAD.
+	TeamLeadRuleRequire = "require_lead"
+	// TeamLeadRuleExclude - LEAD не назначаются автоматически.
+	TeamLeadRuleExclude = "exclude_leads"
+)
+
+// ReviewerRule - правило подбора ревьюверов для автора.
+type ReviewerRule struct {
+	Kind       ReviewerRuleKind
+	AuthorID   string
+	ReviewerID string
+}
+
+// ReviewerRuleKind - вид правила подбора ревьюверов.
+type ReviewerRuleKind = string
+
+const (
+	// ReviewerRuleKindNeverReview - ревьювер никогда не назначается на PR автора.
+	ReviewerRuleKindNeverReview = "never_review"
+	// ReviewerRuleKindPrefer - ревьювер назначается на PR автора в первую очередь.
+	ReviewerRuleKindPrefer = "prefer"
+)
+
 type PullRequestStatus = string
 
 const (
 	PullRequestStatusOpen   = "OPEN"
 	PullRequestStatusMerged = "MERGED"
+	// PullRequestStatusClosed - PR закрыт без мержа и не учитывается в нагрузке ревьюверов.
+	PullRequestStatusClosed = "CLOSED"
+	// PullRequestStatusDraft - черновик: ревьюверы назначаются после перевода в OPEN.
+	PullRequestStatusDraft = "DRAFT"
 )
 
 type PRReviewerHistoryChangeReason = string
@@ -43,4 +189,10 @@ type PRReviewerHistoryChangeReason = string
 const (
 	PRReviewerHistoryChangeReasonInitial      = "initial"
 	PRReviewerHistoryChangeReasonReassignment = "reassignment"
+	PRReviewerHistoryChangeReasonDeactivation = "deactivation"
+	PRReviewerHistoryChangeReasonTopUp        = "top_up"
+	PRReviewerHistoryChangeReasonTeamLeave    = "team_leave"
+	PRReviewerHistoryChangeReasonManual       = "manual"
+	// PRReviewerHistoryChangeReasonUnavailable - передача ревью после начала периода недоступности.
+	PRReviewerHistoryChangeReasonUnavailable = "unavailable"
 )
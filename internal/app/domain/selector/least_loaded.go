@@ -0,0 +1,18 @@
+package selector
+
+import (
+	"cmp"
+)
+
+// LeastLoaded выбирает кандидатов с наименьшим количеством открытых ревью.
+type LeastLoaded struct {
+	opts Options
+}
+
+func (s LeastLoaded) Select(candidates []Candidate, count int) []Candidate {
+	sorted := sortByPriority(candidates, s.opts, func(a, b Candidate) int {
+		return cmp.Compare(a.OpenReviews, b.OpenReviews)
+	})
+
+	return sorted[:min(count, len(sorted))]
+}
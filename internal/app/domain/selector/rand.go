@@ -0,0 +1,32 @@
+package selector
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// Rand - потокобезопасная обертка над генератором случайных чисел.
+//
+// Фиксированный seed делает выбор ревьюверов воспроизводимым (например, в тестах).
+type Rand struct {
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+func NewRand(seed int64) *Rand {
+	return &Rand{rnd: rand.New(rand.NewSource(seed))} //nolint:gosec // Не принципиально
+}
+
+func (r *Rand) Shuffle(n int, swap func(i, j int)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rnd.Shuffle(n, swap)
+}
+
+func (r *Rand) Float64() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.rnd.Float64()
+}
@@ -0,0 +1,20 @@
+package selector
+
+import (
+	"slices"
+)
+
+// Random выбирает кандидатов случайным образом.
+type Random struct {
+	opts Options
+}
+
+func (s Random) Select(candidates []Candidate, count int) []Candidate {
+	shuffled := slices.Clone(candidates)
+
+	s.opts.Rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return shuffled[:min(count, len(shuffled))]
+}
@@ -0,0 +1,17 @@
+package selector
+
+// RoundRobin выбирает кандидатов, которых дольше всего не назначали на ревью.
+//
+// Состояние очереди не хранится отдельно - оно восстанавливается по времени последнего
+// назначения, поэтому стратегия одинаково работает на нескольких репликах сервиса.
+type RoundRobin struct {
+	opts Options
+}
+
+func (s RoundRobin) Select(candidates []Candidate, count int) []Candidate {
+	sorted := sortByPriority(candidates, s.opts, func(a, b Candidate) int {
+		return a.LastAssignedAt.Compare(b.LastAssignedAt)
+	})
+
+	return sorted[:min(count, len(sorted))]
+}
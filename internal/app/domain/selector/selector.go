@@ -0,0 +1,127 @@
+package selector
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"pr-reviewer-assign-service/internal/app/data"
+)
+
+type Strategy = string
+
+const (
+	StrategyRandom      Strategy = "random"
+	StrategyRoundRobin  Strategy = "round_robin"
+	StrategyLeastLoaded Strategy = "least_loaded"
+	StrategyWeighted    Strategy = "weighted"
+)
+
+// TieBreak определяет порядок кандидатов с одинаковым приоритетом.
+type TieBreak = string
+
+const (
+	// TieBreakDeterministic упорядочивает равных кандидатов по имени пользователя.
+	TieBreakDeterministic TieBreak = "deterministic"
+	// TieBreakRandom перемешивает равных кандидатов генератором из Options.
+	TieBreakRandom TieBreak = "random"
+)
+
+// Candidate - кандидат в ревьюверы вместе с данными, нужными стратегиям выбора.
+type Candidate struct {
+	User data.User
+	// OpenReviews - количество текущих назначений на открытые PR.
+	OpenReviews int64
+	// LastAssignedAt - время последнего назначения (нулевое, если назначений не было).
+	LastAssignedAt time.Time
+	// UntilWorkingHours - время до начала ближайших рабочих часов
+	// (0, если кандидат в рабочих часах или рабочие часы не заданы).
+	UntilWorkingHours time.Duration
+}
+
+// ReviewerSelector выбирает ревьюверов из заранее отфильтрованного списка кандидатов.
+type ReviewerSelector interface {
+	// Select возвращает не более count кандидатов в порядке приоритета.
+	Select(candidates []Candidate, count int) []Candidate
+}
+
+// Options - общие настройки стратегий.
+type Options struct {
+	TieBreak TieBreak
+	Rand     *Rand
+	// PreferWorkingHours - выбирать сначала кандидатов в рабочих часах (см. WorkingHoursFirst).
+	PreferWorkingHours bool
+}
+
+// New возвращает реализацию стратегии по ее названию.
+func New(strategy Strategy, opts Options) (ReviewerSelector, error) {
+	if opts.Rand == nil {
+		opts.Rand = NewRand(time.Now().UnixNano())
+	}
+
+	var base ReviewerSelector
+
+	switch strategy {
+	case StrategyRandom:
+		base = Random{opts: opts}
+	case StrategyRoundRobin:
+		base = RoundRobin{opts: opts}
+	case StrategyLeastLoaded:
+		base = LeastLoaded{opts: opts}
+	case StrategyWeighted:
+		base = Weighted{opts: opts}
+	default:
+		return nil, fmt.Errorf("unknown reviewer selection strategy %q", strategy)
+	}
+
+	if opts.PreferWorkingHours {
+		return WorkingHoursFirst{base: base}, nil
+	}
+
+	return base, nil
+}
+
+// IsKnown проверяет, что стратегия с таким названием существует.
+func IsKnown(strategy Strategy) bool {
+	switch strategy {
+	case StrategyRandom, StrategyRoundRobin, StrategyLeastLoaded, StrategyWeighted:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsKnownTieBreak проверяет, что способ разрешения равенства существует.
+func IsKnownTieBreak(tieBreak TieBreak) bool {
+	return tieBreak == TieBreakDeterministic || tieBreak == TieBreakRandom
+}
+
+// sortByPriority упорядочивает кандидатов по compare, разрешая равенство согласно opts.TieBreak.
+func sortByPriority(
+	candidates []Candidate,
+	opts Options,
+	compare func(a, b Candidate) int,
+) []Candidate {
+	sorted := slices.Clone(candidates)
+
+	if opts.TieBreak == TieBreakRandom {
+		opts.Rand.Shuffle(len(sorted), func(i, j int) {
+			sorted[i], sorted[j] = sorted[j], sorted[i]
+		})
+
+		slices.SortStableFunc(sorted, compare)
+
+		return sorted
+	}
+
+	slices.SortStableFunc(sorted, func(a, b Candidate) int {
+		if c := compare(a, b); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.User.Username, b.User.Username)
+	})
+
+	return sorted
+}
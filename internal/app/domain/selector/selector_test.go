@@ -0,0 +1,205 @@
+package selector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assign-service/internal/app/data"
+)
+
+func candidate(username string, openReviews int64, lastAssignedAt time.Time) Candidate {
+	return Candidate{
+		User:           data.User{ID: uuid.New(), Username: username},
+		OpenReviews:    openReviews,
+		LastAssignedAt: lastAssignedAt,
+	}
+}
+
+func usernames(candidates []Candidate) []string {
+	names := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		names = append(names, c.User.Username)
+	}
+
+	return names
+}
+
+func TestNew(t *testing.T) {
+	for _, strategy := range []Strategy{StrategyRandom, StrategyRoundRobin, StrategyLeastLoaded, StrategyWeighted} {
+		assert.True(t, IsKnown(strategy), strategy)
+
+		_, err := New(strategy, Options{})
+		assert.NoError(t, err, strategy)
+	}
+
+	assert.False(t, IsKnown("unknown"))
+
+	_, err := New("unknown", Options{})
+	assert.Error(t, err)
+
+	assert.True(t, IsKnownTieBreak(TieBreakDeterministic))
+	assert.True(t, IsKnownTieBreak(TieBreakRandom))
+	assert.False(t, IsKnownTieBreak("unknown"))
+}
+
+func TestLeastLoaded(t *testing.T) {
+	candidates := []Candidate{
+		candidate("carol", 2, time.Time{}),
+		candidate("bob", 0, time.Time{}),
+		candidate("dave", 5, time.Time{}),
+		candidate("alice", 0, time.Time{}),
+	}
+
+	tests := []struct {
+		name  string
+		count int
+		want  []string
+	}{
+		{name: "single", count: 1, want: []string{"alice"}},
+		{name: "ties ordered by username", count: 3, want: []string{"alice", "bob", "carol"}},
+		{name: "count exceeds candidates", count: 10, want: []string{"alice", "bob", "carol", "dave"}},
+		{name: "zero", count: 0, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := New(StrategyLeastLoaded, Options{TieBreak: TieBreakDeterministic})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, usernames(s.Select(candidates, tt.count)))
+		})
+	}
+}
+
+func TestRoundRobin(t *testing.T) {
+	now := time.Now()
+
+	candidates := []Candidate{
+		candidate("carol", 0, now.Add(-time.Hour)),
+		candidate("bob", 0, now),
+		candidate("dave", 0, time.Time{}),
+		candidate("alice", 0, now.Add(-2*time.Hour)),
+	}
+
+	s, err := New(StrategyRoundRobin, Options{TieBreak: TieBreakDeterministic})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"dave", "alice", "carol"}, usernames(s.Select(candidates, 3)))
+}
+
+func TestRandomTieBreak(t *testing.T) {
+	candidates := []Candidate{
+		candidate("alice", 0, time.Time{}),
+		candidate("bob", 0, time.Time{}),
+		candidate("carol", 0, time.Time{}),
+		candidate("dave", 1, time.Time{}),
+	}
+
+	seen := make(map[string]bool)
+
+	for seed := range int64(50) {
+		s, err := New(StrategyLeastLoaded, Options{TieBreak: TieBreakRandom, Rand: NewRand(seed)})
+		require.NoError(t, err)
+
+		selected := usernames(s.Select(candidates, 3))
+		assert.ElementsMatch(t, []string{"alice", "bob", "carol"}, selected)
+
+		seen[selected[0]] = true
+	}
+
+	assert.Len(t, seen, 3)
+}
+
+func TestRandom(t *testing.T) {
+	candidates := []Candidate{
+		candidate("alice", 0, time.Time{}),
+		candidate("bob", 0, time.Time{}),
+		candidate("carol", 0, time.Time{}),
+	}
+
+	first, err := New(StrategyRandom, Options{Rand: NewRand(42)})
+	require.NoError(t, err)
+
+	second, err := New(StrategyRandom, Options{Rand: NewRand(42)})
+	require.NoError(t, err)
+
+	for range 10 {
+		selected := first.Select(candidates, 2)
+		assert.Len(t, selected, 2)
+		assert.NotEqual(t, selected[0].User.ID, selected[1].User.ID)
+		assert.Equal(t, usernames(selected), usernames(second.Select(candidates, 2)))
+	}
+
+	assert.Equal(t, []string{"alice", "bob", "carol"}, usernames(candidates))
+}
+
+func TestWeighted(t *testing.T) {
+	candidates := []Candidate{
+		candidate("idle", 0, time.Time{}),
+		candidate("busy", 9, time.Time{}),
+	}
+
+	s, err := New(StrategyWeighted, Options{Rand: NewRand(1)})
+	require.NoError(t, err)
+
+	picks := make(map[string]int)
+
+	for range 1000 {
+		selected := s.Select(candidates, 1)
+		require.Len(t, selected, 1)
+
+		picks[selected[0].User.Username]++
+	}
+
+	// Вес свободного ревьювера в 10 раз больше: ожидается около 909 выборов из 1000.
+	assert.Greater(t, picks["idle"], 850)
+	assert.Positive(t, picks["busy"])
+
+	assert.ElementsMatch(t, []string{"idle", "busy"}, usernames(s.Select(candidates, 5)))
+}
+
+func TestWorkingHoursFirst(t *testing.T) {
+	waiting := func(username string, openReviews int64, untilWorkingHours time.Duration) Candidate {
+		c := candidate(username, openReviews, time.Time{})
+		c.UntilWorkingHours = untilWorkingHours
+
+		return c
+	}
+
+	candidates := []Candidate{
+		waiting("later", 0, 48*time.Hour),
+		waiting("busy", 3, 0),
+		waiting("soon", 0, 2*time.Hour),
+		waiting("idle", 0, 0),
+		waiting("soon-busy", 1, 2*time.Hour),
+	}
+
+	tests := []struct {
+		name  string
+		count int
+		want  []string
+	}{
+		{name: "working hours first, base strategy inside group", count: 2, want: []string{"idle", "busy"}},
+		{name: "then earliest working hours", count: 4, want: []string{"idle", "busy", "soon", "soon-busy"}},
+		{name: "all", count: 10, want: []string{"idle", "busy", "soon", "soon-busy", "later"}},
+		{name: "zero", count: 0, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := New(StrategyLeastLoaded, Options{TieBreak: TieBreakDeterministic, PreferWorkingHours: true})
+			require.NoError(t, err)
+			require.IsType(t, WorkingHoursFirst{}, s)
+
+			assert.Equal(t, tt.want, usernames(s.Select(candidates, tt.count)))
+		})
+	}
+
+	s, err := New(StrategyLeastLoaded, Options{TieBreak: TieBreakDeterministic})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"idle", "later", "soon"}, usernames(s.Select(candidates, 3)))
+}
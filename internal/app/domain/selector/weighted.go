@@ -0,0 +1,45 @@
+package selector
+
+import (
+	"slices"
+)
+
+// Weighted выбирает кандидатов случайно, но с весом, обратно пропорциональным нагрузке:
+// у ревьювера без открытых ревью шанс в два раза выше, чем у ревьювера с одним ревью и т.д.
+type Weighted struct {
+	opts Options
+}
+
+func (s Weighted) Select(candidates []Candidate, count int) []Candidate {
+	pool := slices.Clone(candidates)
+	selected := make([]Candidate, 0, min(count, len(pool)))
+
+	for len(selected) < count && len(pool) > 0 {
+		var total float64
+		for _, c := range pool {
+			total += weight(c)
+		}
+
+		point := s.opts.Rand.Float64() * total
+
+		index := len(pool) - 1
+
+		for i, c := range pool {
+			point -= weight(c)
+			if point < 0 {
+				index = i
+
+				break
+			}
+		}
+
+		selected = append(selected, pool[index])
+		pool = slices.Delete(pool, index, index+1)
+	}
+
+	return selected
+}
+
+func weight(c Candidate) float64 {
+	return 1 / float64(1+c.OpenReviews)
+}
@@ -0,0 +1,34 @@
+package selector
+
+import (
+	"cmp"
+	"slices"
+)
+
+// WorkingHoursFirst отдает предпочтение кандидатам в рабочих часах: сначала выбираются кандидаты,
+// находящиеся в рабочих часах, затем - кандидаты, чьи рабочие часы начнутся раньше.
+// Среди кандидатов с одинаковым временем до начала рабочих часов выбирает базовая стратегия.
+type WorkingHoursFirst struct {
+	base ReviewerSelector
+}
+
+func (s WorkingHoursFirst) Select(candidates []Candidate, count int) []Candidate {
+	sorted := slices.Clone(candidates)
+	slices.SortStableFunc(sorted, func(a, b Candidate) int {
+		return cmp.Compare(a.UntilWorkingHours, b.UntilWorkingHours)
+	})
+
+	selected := make([]Candidate, 0, min(count, len(sorted)))
+
+	for len(sorted) > 0 && len(selected) < count {
+		groupEnd := 1
+		for groupEnd < len(sorted) && sorted[groupEnd].UntilWorkingHours == sorted[0].UntilWorkingHours {
+			groupEnd++
+		}
+
+		selected = append(selected, s.base.Select(sorted[:groupEnd], count-len(selected))...)
+		sorted = sorted[groupEnd:]
+	}
+
+	return selected
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/actor"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// requestActor возвращает внутренний ID инициатора запроса и указанную им причину изменения.
+// Инициатор ищется один раз за запрос; если он не передан, ID пустой.
+func (u *UseCase) requestActor(
+	ctx context.Context,
+) (sql.Null[data.UserInternalID], sql.NullString, error) {
+	a, ok := actor.FromCtx(ctx)
+	if !ok {
+		return sql.Null[data.UserInternalID]{}, sql.NullString{}, nil
+	}
+
+	reason := sql.NullString{String: a.Reason, Valid: a.Reason != ""}
+
+	if a.UserID == "" {
+		return sql.Null[data.UserInternalID]{}, reason, nil
+	}
+
+	id, err := a.Resolve(func(userID string) (uuid.UUID, error) {
+		user, err := u.repo.GetUserByExternalID(ctx, userID)
+		if errors.Is(err, api.ErrNotFound) {
+			return uuid.Nil, errors.New(api.ErrUnknownActor)
+		}
+
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting actor by external id",
+				zap.Error(err),
+				zap.String("UserID", userID))
+
+			return uuid.Nil, err
+		}
+
+		return user.ID, nil
+	})
+	if err != nil {
+		return sql.Null[data.UserInternalID]{}, sql.NullString{}, err
+	}
+
+	return sql.Null[data.UserInternalID]{V: id, Valid: true}, reason, nil
+}
+
+// actorID возвращает внутренний ID пользователя, явно указанного в запросе как выполняющего
+// изменение. Если инициатор запроса известен, указанный пользователь не учитывается; если
+// ID пользователя без токена не принимаются, возвращается ошибка, как и для заголовка.
+func (u *UseCase) actorID(
+	ctx context.Context,
+	externalID string,
+) (sql.Null[data.UserInternalID], error) {
+	if externalID == "" {
+		return sql.Null[data.UserInternalID]{}, nil
+	}
+
+	if a, ok := actor.FromCtx(ctx); ok {
+		if a.UserID != "" {
+			return sql.Null[data.UserInternalID]{}, nil
+		}
+
+		if !a.HeaderTrusted {
+			return sql.Null[data.UserInternalID]{}, errors.New(api.ErrActorHeaderNotTrusted)
+		}
+	}
+
+	user, err := u.repo.GetUserByExternalID(ctx, externalID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting user by external id",
+			zap.Error(err),
+			zap.String("UserID", externalID))
+
+		return sql.Null[data.UserInternalID]{}, err
+	}
+
+	return sql.Null[data.UserInternalID]{V: user.ID, Valid: true}, nil
+}
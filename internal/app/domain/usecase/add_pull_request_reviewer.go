@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type AddPullRequestReviewerParams struct {
+	PullRequestID string
+	ReviewerID    string
+	// ChangedBy - внешний ID пользователя, выполняющего изменение, если инициатор запроса неизвестен.
+	ChangedBy string
+}
+
+type AddPullRequestReviewerResult struct {
+	PR model.PullRequest
+}
+
+// AddPullRequestReviewer назначает на PR указанного ревьювера в дополнение к текущим.
+// Ревьювер проверяется так же, как при явной замене (см. validateExplicitReviewer).
+func (u *UseCase) AddPullRequestReviewer(
+	ctx context.Context,
+	params AddPullRequestReviewerParams,
+) (AddPullRequestReviewerResult, error) {
+	var result AddPullRequestReviewerResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		pr, err := u.repo.GetPullRequestByExternalID(ctx, params.PullRequestID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting pr by external id", zap.Error(err))
+
+			return err
+		}
+
+		reviewer, err := u.repo.GetUserByExternalID(ctx, params.ReviewerID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", params.ReviewerID))
+
+			return err
+		}
+
+		changedBy, err := u.actorID(ctx, params.ChangedBy)
+		if err != nil {
+			return err
+		}
+
+		reviewers, err := u.repo.GetCurrentReviewers(ctx, pr.ID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting current reviewers", zap.Error(err))
+
+			return err
+		}
+
+		teamID, err := u.reviewerTeamID(ctx, pr, reviewer.ID)
+		if err != nil {
+			return err
+		}
+
+		team, err := u.repo.GetTeamByID(ctx, teamID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by id", zap.Error(err))
+
+			return err
+		}
+
+		err = u.validateExplicitReviewer(ctx, pr, reviewers, reviewer, team)
+		if err != nil {
+			return err
+		}
+
+		err = u.addReviewer(
+			ctx,
+			pr.ID,
+			reviewer.ID,
+			teamID,
+			changedBy,
+			model.PRReviewerHistoryChangeReasonManual,
+		)
+		if err != nil {
+			return err
+		}
+
+		pr, err = u.refreshNeedMoreReviewers(ctx, pr)
+		if err != nil {
+			return err
+		}
+
+		result.PR, err = u.pullRequestModel(ctx, pr)
+
+		return err
+	})
+	if err != nil {
+		return AddPullRequestReviewerResult{}, err
+	}
+
+	return result, nil
+}
+
+// reviewerTeamID возвращает команду, от имени которой пользователь назначается на PR:
+// команду PR, если пользователь в ней состоит, иначе его основную команду.
+func (u *UseCase) reviewerTeamID(
+	ctx context.Context,
+	pr data.PullRequest,
+	userID uuid.UUID,
+) (uuid.UUID, error) {
+	if pr.TeamID.Valid {
+		_, err := u.repo.GetTeamMemberByTeamAndUser(ctx, pr.TeamID.V, userID)
+		if err == nil {
+			return pr.TeamID.V, nil
+		}
+
+		if !errors.Is(err, api.ErrNotFound) {
+			log.LoggerFromCtx(ctx).Error("error getting team member", zap.Error(err))
+
+			return uuid.Nil, err
+		}
+	}
+
+	team, err := u.userPrimaryTeam(ctx, userID)
+	if err != nil {
+		return uuid.Nil, errors.New(api.ErrNotTeamMember)
+	}
+
+	return team.ID, nil
+}
+
+// refreshNeedMoreReviewers пересчитывает флаг need_more_reviewers PR по текущему составу ревьюверов.
+func (u *UseCase) refreshNeedMoreReviewers(
+	ctx context.Context,
+	pr data.PullRequest,
+) (data.PullRequest, error) {
+	if !pr.TeamID.Valid {
+		return pr, nil
+	}
+
+	team, err := u.repo.GetTeamByID(ctx, pr.TeamID.V)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team by id", zap.Error(err))
+
+		return data.PullRequest{}, err
+	}
+
+	reviewers, err := u.repo.GetCurrentReviewers(ctx, pr.ID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting current reviewers", zap.Error(err))
+
+		return data.PullRequest{}, err
+	}
+
+	leadMissing, err := u.leadMissing(ctx, team, reviewerIDsWithout(reviewers, uuid.Nil))
+	if err != nil {
+		return data.PullRequest{}, err
+	}
+
+	needMoreReviewers := len(reviewers) < u.teamRequiredReviewers(team) || leadMissing
+	if needMoreReviewers == pr.NeedMoreReviewers {
+		return pr, nil
+	}
+
+	pr.NeedMoreReviewers = needMoreReviewers
+
+	updatedPR, err := u.repo.UpdatePullRequest(ctx, pr)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error updating pr", zap.Error(err))
+
+		return data.PullRequest{}, err
+	}
+
+	return updatedPR, nil
+}
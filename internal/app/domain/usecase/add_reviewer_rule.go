@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type AddReviewerRuleParams struct {
+	Kind       model.ReviewerRuleKind
+	AuthorID   string
+	ReviewerID string
+}
+
+type AddReviewerRuleResult struct {
+	Rule model.ReviewerRule
+}
+
+// AddReviewerRule задает правило для пары автор-ревьювер, заменяя существующее.
+// Уже сделанные назначения правило не меняет.
+func (u *UseCase) AddReviewerRule(
+	ctx context.Context,
+	params AddReviewerRuleParams,
+) (AddReviewerRuleResult, error) {
+	if !isKnownRuleKind(params.Kind) {
+		return AddReviewerRuleResult{}, errors.New(api.ErrUnknownRuleKind)
+	}
+
+	if params.AuthorID == params.ReviewerID {
+		return AddReviewerRuleResult{}, errors.New(api.ErrInvalidReviewerRule)
+	}
+
+	var result AddReviewerRuleResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		author, err := u.repo.GetUserByExternalID(ctx, params.AuthorID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", params.AuthorID))
+
+			return err
+		}
+
+		reviewer, err := u.repo.GetUserByExternalID(ctx, params.ReviewerID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", params.ReviewerID))
+
+			return err
+		}
+
+		rule, err := u.repo.UpsertReviewerRule(ctx, data.ReviewerRule{
+			ID:         uuid.New(),
+			AuthorID:   author.ID,
+			ReviewerID: reviewer.ID,
+			Kind:       params.Kind,
+			CreatedAt:  time.Now(),
+		})
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error upserting reviewer rule", zap.Error(err))
+
+			return err
+		}
+
+		result.Rule, err = u.toReviewerRule(ctx, rule)
+
+		return err
+	})
+	if err != nil {
+		return AddReviewerRuleResult{}, err
+	}
+
+	return result, nil
+}
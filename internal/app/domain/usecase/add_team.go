@@ -2,100 +2,111 @@ package usecase
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
 	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/internal/app/domain/selector"
+	"pr-reviewer-assign-service/pkg/errors"
 	"pr-reviewer-assign-service/pkg/log"
 )
 
 type AddTeamParams struct {
-	TeamName string
-	Members  []TeamMemberParams
+	TeamName          string
+	ReviewerStrategy  string
+	RequiredReviewers *int
+	LeadRule          model.TeamLeadRule
+	Members           []TeamMemberParams
+	// RemoveMissing исключает из команды участников, которых нет в Members.
+	RemoveMissing bool
+	// MoveMembers исключает участников из остальных команд и делает эту команду основной.
+	MoveMembers bool
 }
 
 type TeamMemberParams struct {
 	UserID   string
 	Username string
+	// Email - адрес пользователя; пустой адрес не меняет сохраненный.
+	Email    string
 	IsActive bool
 }
 
+// TeamDiff описывает изменения, внесенные AddTeam (внешние ID пользователей).
+type TeamDiff struct {
+	TeamCreated bool
+	// CreatedUsers - новые пользователи.
+	CreatedUsers []string
+	// UpdatedUsers - существующие пользователи, у которых изменились имя или флаг активности.
+	UpdatedUsers []string
+	// AddedMembers - пользователи, вступившие в команду.
+	AddedMembers []string
+	// RemovedMembers - пользователи, исключенные из команды.
+	RemovedMembers []string
+	// HandOff - ревью, переданные из-за деактивации или исключения пользователей.
+	HandOff ReviewHandOff
+}
+
 type AddTeamResult struct {
 	Team model.Team
+	Diff TeamDiff
 }
 
+// AddTeam создает команду или обновляет существующую. Пользователи сопоставляются по внешнему ID:
+// новые создаются, у существующих обновляются имя и флаг активности.
 func (u *UseCase) AddTeam(ctx context.Context, params AddTeamParams) (AddTeamResult, error) {
 	var result AddTeamResult
 
-	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
-		team := data.Team{
-			ID:          uuid.New(),
-			ExternalID:  uuid.NewString(),
-			Name:        params.TeamName,
-			Description: "",
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
-		}
+	if params.ReviewerStrategy != "" && !selector.IsKnown(params.ReviewerStrategy) {
+		return AddTeamResult{}, errors.New(api.ErrUnknownStrategy)
+	}
 
-		createdTeam, err := u.repo.CreateTeam(ctx, team)
-		if err != nil {
-			log.LoggerFromCtx(ctx).Error("error creating team", zap.Error(err))
+	if params.RequiredReviewers != nil && *params.RequiredReviewers < 0 {
+		return AddTeamResult{}, errors.New(api.ErrInvalidRequiredReviewers)
+	}
 
+	if params.LeadRule != "" && !isKnownLeadRule(params.LeadRule) {
+		return AddTeamResult{}, errors.New(api.ErrUnknownLeadRule)
+	}
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		team, created, err := u.upsertTeam(ctx, params)
+		if err != nil {
 			return err
 		}
 
-		createdMembers := make([]model.TeamMember, 0, len(params.Members))
+		result.Diff.TeamCreated = created
 
-		for _, member := range params.Members {
-			user := data.User{
-				ID:         uuid.New(),
-				ExternalID: member.UserID,
-				Username:   member.Username,
-				Email:      fmt.Sprintf("%s@example.com", member.UserID), // HACK Генерируем email
-				IsActive:   member.IsActive,
-				CreatedAt:  time.Now(),
-				UpdatedAt:  time.Now(),
-			}
+		inPayload := make(map[uuid.UUID]struct{}, len(params.Members))
 
-			createdUser, err := u.repo.CreateUser(ctx, user)
+		for _, member := range params.Members {
+			user, err := u.upsertTeamMember(ctx, team, member, params.MoveMembers, &result.Diff)
 			if err != nil {
-				log.LoggerFromCtx(ctx).Error("error creating user", zap.Error(err))
-
 				return err
 			}
 
-			teamMember := data.TeamMember{
-				ID:        uuid.New(),
-				TeamID:    createdTeam.ID,
-				UserID:    createdUser.ID,
-				Role:      "MEMBER",
-				CreatedAt: time.Now(),
-			}
+			inPayload[user.ID] = struct{}{}
+		}
 
-			_, err = u.repo.CreateTeamMember(ctx, teamMember)
+		if params.RemoveMissing {
+			err = u.removeMissingMembers(ctx, team, inPayload, &result.Diff)
 			if err != nil {
-				log.LoggerFromCtx(ctx).Error("error creating team member", zap.Error(err))
-
 				return err
 			}
-
-			createdMembers = append(createdMembers, model.TeamMember{
-				UserID:   user.ExternalID,
-				Username: user.Username,
-				IsActive: user.IsActive,
-			})
 		}
 
-		result.Team = model.Team{
-			TeamName: createdTeam.Name,
-			Members:  createdMembers,
+		members, err := u.teamMembers(ctx, team.ID)
+		if err != nil {
+			return err
 		}
 
-		return nil
+		result.Team, err = u.teamModel(ctx, team, members)
+
+		return err
 	})
 	if err != nil {
 		return AddTeamResult{}, err
@@ -103,3 +114,231 @@ func (u *UseCase) AddTeam(ctx context.Context, params AddTeamParams) (AddTeamRes
 
 	return result, nil
 }
+
+// upsertTeam создает команду или обновляет настройки существующей, если они переданы.
+func (u *UseCase) upsertTeam(ctx context.Context, params AddTeamParams) (data.Team, bool, error) {
+	team, err := u.repo.GetTeamByName(ctx, params.TeamName)
+	if err != nil && !errors.Is(err, api.ErrNotFound) {
+		log.LoggerFromCtx(ctx).Error("error getting team by name", zap.Error(err))
+
+		return data.Team{}, false, err
+	}
+
+	if err == nil {
+		if params.ReviewerStrategy == "" && params.RequiredReviewers == nil && params.LeadRule == "" {
+			return team, false, nil
+		}
+
+		if params.ReviewerStrategy != "" {
+			team.ReviewerStrategy = sql.Null[string]{V: params.ReviewerStrategy, Valid: true}
+		}
+
+		if params.RequiredReviewers != nil {
+			team.RequiredReviewers = sql.Null[int]{V: *params.RequiredReviewers, Valid: true}
+		}
+
+		if params.LeadRule != "" {
+			team.LeadRule = sql.Null[string]{V: params.LeadRule, Valid: true}
+		}
+
+		team, err = u.repo.UpdateTeam(ctx, team)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error updating team", zap.Error(err))
+
+			return data.Team{}, false, err
+		}
+
+		return team, false, nil
+	}
+
+	team = data.Team{
+		ID:          uuid.New(),
+		ExternalID:  uuid.NewString(),
+		Name:        params.TeamName,
+		Description: "",
+		ReviewerStrategy: sql.Null[string]{
+			V:     params.ReviewerStrategy,
+			Valid: params.ReviewerStrategy != "",
+		},
+		LeadRule: sql.Null[string]{
+			V:     params.LeadRule,
+			Valid: params.LeadRule != "",
+		},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if params.RequiredReviewers != nil {
+		team.RequiredReviewers = sql.Null[int]{V: *params.RequiredReviewers, Valid: true}
+	}
+
+	createdTeam, err := u.repo.CreateTeam(ctx, team)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error creating team", zap.Error(err))
+
+		return data.Team{}, false, err
+	}
+
+	return createdTeam, true, nil
+}
+
+// upsertTeamMember создает или обновляет пользователя и добавляет его в команду.
+func (u *UseCase) upsertTeamMember(
+	ctx context.Context,
+	team data.Team,
+	member TeamMemberParams,
+	move bool,
+	diff *TeamDiff,
+) (data.User, error) {
+	user, err := u.repo.GetUserByExternalID(ctx, member.UserID)
+	if err != nil && !errors.Is(err, api.ErrNotFound) {
+		log.LoggerFromCtx(ctx).Error("error getting user by external id", zap.Error(err))
+
+		return data.User{}, err
+	}
+
+	userExists := err == nil
+
+	email, err := normalizeEmail(member.Email)
+	if err != nil {
+		return data.User{}, err
+	}
+
+	if !userExists {
+		userID := uuid.New()
+
+		err = u.ensureEmailAvailable(ctx, email, userID)
+		if err != nil {
+			return data.User{}, err
+		}
+
+		user, err = u.repo.CreateUser(ctx, data.User{
+			ID:         userID,
+			ExternalID: member.UserID,
+			Username:   member.Username,
+			Email:      email,
+			IsActive:   member.IsActive,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+		})
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error creating user", zap.Error(err))
+
+			return data.User{}, err
+		}
+
+		diff.CreatedUsers = append(diff.CreatedUsers, user.ExternalID)
+	} else if user.Username != member.Username || user.IsActive != member.IsActive ||
+		(email.Valid && email != user.Email) {
+		updated := user
+		updated.Username = member.Username
+		updated.IsActive = member.IsActive
+
+		if email.Valid && email != user.Email {
+			err = u.ensureEmailAvailable(ctx, email, user.ID)
+			if err != nil {
+				return data.User{}, err
+			}
+
+			updated.Email = email
+		}
+
+		updated, err = u.repo.UpdateUser(ctx, updated)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error updating user", zap.Error(err))
+
+			return data.User{}, err
+		}
+
+		handOff, err := u.applyActivityChange(ctx, user, updated)
+		if err != nil {
+			return data.User{}, err
+		}
+
+		diff.HandOff.merge(handOff)
+		diff.UpdatedUsers = append(diff.UpdatedUsers, user.ExternalID)
+		user = updated
+	}
+
+	_, joined, err := u.joinTeam(ctx, user, team.ID, model.TeamMemberRoleMember, move)
+	if err != nil {
+		return data.User{}, err
+	}
+
+	if joined {
+		diff.AddedMembers = append(diff.AddedMembers, user.ExternalID)
+	}
+
+	if !move {
+		return user, nil
+	}
+
+	teamMembers, err := u.repo.GetTeamMembersByUserID(ctx, user.ID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team members", zap.Error(err))
+
+		return data.User{}, err
+	}
+
+	for _, tm := range teamMembers {
+		if tm.TeamID == team.ID {
+			continue
+		}
+
+		handOff, err := u.leaveTeam(ctx, user, tm)
+		if err != nil {
+			return data.User{}, err
+		}
+
+		diff.HandOff.merge(handOff)
+	}
+
+	return user, nil
+}
+
+// removeMissingMembers исключает из команды участников, которых нет в keep. Их открытые ревью
+// передаются после исключения всех таких участников.
+func (u *UseCase) removeMissingMembers(
+	ctx context.Context,
+	team data.Team,
+	keep map[uuid.UUID]struct{},
+	diff *TeamDiff,
+) error {
+	teamMembers, err := u.repo.GetTeamMembersByTeamID(ctx, team.ID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team members", zap.Error(err))
+
+		return err
+	}
+
+	var (
+		removedUsers   []data.User
+		removedMembers []data.TeamMember
+	)
+
+	for _, tm := range teamMembers {
+		if _, ok := keep[tm.UserID]; ok {
+			continue
+		}
+
+		user, err := u.repo.GetUserByID(ctx, tm.UserID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by id", zap.Error(err))
+
+			return err
+		}
+
+		removedUsers = append(removedUsers, user)
+		removedMembers = append(removedMembers, tm)
+		diff.RemovedMembers = append(diff.RemovedMembers, user.ExternalID)
+	}
+
+	handOff, err := u.leaveTeamTogether(ctx, removedUsers, removedMembers)
+	if err != nil {
+		return err
+	}
+
+	diff.HandOff.merge(handOff)
+
+	return nil
+}
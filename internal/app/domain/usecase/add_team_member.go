@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type AddTeamMemberParams struct {
+	TeamName string
+	UserID   string
+	// Role - роль участника, по умолчанию MEMBER.
+	Role model.TeamMemberRole
+}
+
+type AddTeamMemberResult struct {
+	Team model.Team
+}
+
+// AddTeamMember добавляет существующего пользователя в команду (см. AddUserTeam).
+// Если пользователь уже состоит в команде, возвращается ошибка.
+func (u *UseCase) AddTeamMember(
+	ctx context.Context,
+	params AddTeamMemberParams,
+) (AddTeamMemberResult, error) {
+	var result AddTeamMemberResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		added, err := u.AddUserTeam(ctx, AddUserTeamParams{
+			UserID:   params.UserID,
+			TeamName: params.TeamName,
+			Role:     params.Role,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !added.Joined {
+			return errors.New(api.ErrTeamMemberExists)
+		}
+
+		team, err := u.repo.GetTeamByName(ctx, params.TeamName)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by name",
+				zap.Error(err),
+				zap.String("TeamName", params.TeamName))
+
+			return err
+		}
+
+		members, err := u.teamMembers(ctx, team.ID)
+		if err != nil {
+			return err
+		}
+
+		result.Team, err = u.teamModel(ctx, team, members)
+
+		return err
+	})
+	if err != nil {
+		return AddTeamMemberResult{}, err
+	}
+
+	return result, nil
+}
+
+// isKnownRole проверяет, что роль участника команды существует.
+func isKnownRole(role model.TeamMemberRole) bool {
+	return role == model.TeamMemberRoleMember || role == model.TeamMemberRoleLead
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type AddUnavailabilityPeriodParams struct {
+	UserID   string
+	StartsAt time.Time
+	EndsAt   time.Time
+	Reason   string
+}
+
+type AddUnavailabilityPeriodResult struct {
+	Period model.UnavailabilityPeriod
+}
+
+// AddUnavailabilityPeriod добавляет период, в который пользователь не назначается ревьювером.
+// Уже сделанные назначения не меняются: их передает фоновая задача после начала периода.
+func (u *UseCase) AddUnavailabilityPeriod(
+	ctx context.Context,
+	params AddUnavailabilityPeriodParams,
+) (AddUnavailabilityPeriodResult, error) {
+	err := validateUnavailabilityPeriod(params.StartsAt, params.EndsAt)
+	if err != nil {
+		return AddUnavailabilityPeriodResult{}, err
+	}
+
+	var result AddUnavailabilityPeriodResult
+
+	err = u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		user, err := u.repo.GetUserByExternalID(ctx, params.UserID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", params.UserID))
+
+			return err
+		}
+
+		now := time.Now()
+
+		period, err := u.repo.CreateUnavailabilityPeriod(ctx, data.UnavailabilityPeriod{
+			ID:        uuid.New(),
+			UserID:    user.ID,
+			StartsAt:  params.StartsAt,
+			EndsAt:    params.EndsAt,
+			Reason:    sql.NullString{String: params.Reason, Valid: params.Reason != ""},
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error creating unavailability period", zap.Error(err))
+
+			return err
+		}
+
+		result.Period, err = u.toUnavailabilityPeriod(ctx, period)
+
+		return err
+	})
+	if err != nil {
+		return AddUnavailabilityPeriodResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type AddUserTeamParams struct {
+	UserID   string
+	TeamName string
+	// IsPrimary делает команду основной для пользователя.
+	IsPrimary bool
+	// Role - роль нового участника команды, по умолчанию MEMBER.
+	Role model.TeamMemberRole
+}
+
+type AddUserTeamResult struct {
+	User  model.User
+	Teams []model.UserTeam
+	// Joined - пользователь добавлен в команду этим запросом.
+	Joined bool
+}
+
+// AddUserTeam добавляет пользователя в команду. Если пользователь уже состоит в команде,
+// при необходимости только меняется основная команда.
+func (u *UseCase) AddUserTeam(
+	ctx context.Context,
+	params AddUserTeamParams,
+) (AddUserTeamResult, error) {
+	if params.Role == "" {
+		params.Role = model.TeamMemberRoleMember
+	}
+
+	if !isKnownRole(params.Role) {
+		return AddUserTeamResult{}, errors.New(api.ErrUnknownRole)
+	}
+
+	var result AddUserTeamResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		user, err := u.repo.GetUserByExternalID(ctx, params.UserID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", params.UserID))
+
+			return err
+		}
+
+		team, err := u.repo.GetTeamByName(ctx, params.TeamName)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by name",
+				zap.Error(err),
+				zap.String("TeamName", params.TeamName))
+
+			return err
+		}
+
+		_, result.Joined, err = u.joinTeam(ctx, user, team.ID, params.Role, params.IsPrimary)
+		if err != nil {
+			return err
+		}
+
+		result.Teams, err = u.userTeams(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		result.User = userWithPrimaryTeam(user, result.Teams)
+
+		return nil
+	})
+	if err != nil {
+		return AddUserTeamResult{}, err
+	}
+
+	return result, nil
+}
+
+// userWithPrimaryTeam собирает доменную модель пользователя с названием основной команды.
+func userWithPrimaryTeam(user data.User, teams []model.UserTeam) model.User {
+	result := model.User{
+		UserID:   user.ExternalID,
+		UserName: user.Username,
+		Email:    user.Email.String,
+		IsActive: user.IsActive,
+	}
+
+	for _, team := range teams {
+		if team.IsPrimary {
+			result.TeamName = team.TeamName
+
+			break
+		}
+	}
+
+	return result
+}
+
+// joinTeam добавляет пользователя в команду с ролью role, если он еще в ней не состоит, и
+// доназначает его на PR команды с нехваткой ревьюверов. Первая команда пользователя становится основной.
+// Возвращает участие в команде и признак того, что оно было создано.
+func (u *UseCase) joinTeam(
+	ctx context.Context,
+	user data.User,
+	teamID uuid.UUID,
+	role model.TeamMemberRole,
+	isPrimary bool,
+) (data.TeamMember, bool, error) {
+	var created bool
+
+	teamMember, err := u.repo.GetTeamMemberByTeamAndUser(ctx, teamID, user.ID)
+	if err != nil && !errors.Is(err, api.ErrNotFound) {
+		log.LoggerFromCtx(ctx).Error("error getting team member", zap.Error(err))
+
+		return data.TeamMember{}, false, err
+	}
+
+	if errors.Is(err, api.ErrNotFound) {
+		teamMembers, err := u.repo.GetTeamMembersByUserID(ctx, user.ID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team members", zap.Error(err))
+
+			return data.TeamMember{}, false, err
+		}
+
+		teamMember, err = u.repo.CreateTeamMember(ctx, data.TeamMember{
+			ID:        uuid.New(),
+			TeamID:    teamID,
+			UserID:    user.ID,
+			Role:      role,
+			IsPrimary: len(teamMembers) == 0,
+			CreatedAt: time.Now(),
+		})
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error creating team member", zap.Error(err))
+
+			return data.TeamMember{}, false, err
+		}
+
+		created = true
+
+		_, err = u.topUpPullRequests(ctx, teamID, user)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error topping up PRs", zap.Error(err))
+
+			return data.TeamMember{}, false, fmt.Errorf("failed to top up PRs: %w", err)
+		}
+	}
+
+	if isPrimary && !teamMember.IsPrimary {
+		err = u.repo.SetPrimaryTeamMember(ctx, user.ID, teamMember.ID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error setting primary team", zap.Error(err))
+
+			return data.TeamMember{}, false, err
+		}
+
+		teamMember.IsPrimary = true
+	}
+
+	return teamMember, created, nil
+}
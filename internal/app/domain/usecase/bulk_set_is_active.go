@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+const (
+	// bulkSetIsActiveMaxUsers ограничивает количество пользователей в одном запросе.
+	bulkSetIsActiveMaxUsers = 500
+	// bulkSetIsActiveTimeout ограничивает время выполнения транзакции массового изменения.
+	bulkSetIsActiveTimeout = 30 * time.Second
+)
+
+type BulkSetIsActiveParams struct {
+	// UserIDs - внешние ID пользователей. Не используется вместе с TeamName.
+	UserIDs []string
+	// TeamName - команда, всем участникам которой устанавливается флаг.
+	TeamName string
+	IsActive bool
+}
+
+type BulkSetIsActiveUserResult struct {
+	User model.User
+	// Changed показывает, изменился ли флаг активности пользователя.
+	Changed bool
+	// HandOff заполняется при деактивации пользователя.
+	HandOff ReviewHandOff
+}
+
+type BulkSetIsActiveResult struct {
+	Users []BulkSetIsActiveUserResult
+}
+
+func (u *UseCase) BulkSetIsActive(
+	ctx context.Context,
+	params BulkSetIsActiveParams,
+) (BulkSetIsActiveResult, error) {
+	if (len(params.UserIDs) == 0) == (params.TeamName == "") {
+		return BulkSetIsActiveResult{}, errors.New(api.ErrBulkTargetNotProvided)
+	}
+
+	if len(params.UserIDs) > bulkSetIsActiveMaxUsers {
+		return BulkSetIsActiveResult{}, errors.New(api.ErrTooManyUsers)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, bulkSetIsActiveTimeout)
+	defer cancel()
+
+	var result BulkSetIsActiveResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		users, err := u.bulkTargetUsers(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		if len(users) > bulkSetIsActiveMaxUsers {
+			return errors.New(api.ErrTooManyUsers)
+		}
+
+		// Сначала меняем флаг у всех пользователей, чтобы при передаче ревью
+		// не выбрать в замену того, кто деактивируется в этом же запросе.
+		result.Users = make([]BulkSetIsActiveUserResult, 0, len(users))
+		updatedUsers := make([]data.User, 0, len(users))
+
+		for _, user := range users {
+			updatedUser, err := u.repo.SetUserActive(ctx, user.ID, params.IsActive)
+			if err != nil {
+				log.LoggerFromCtx(ctx).Error("failed to update user",
+					zap.Error(err),
+					zap.String("UserID", user.ExternalID))
+
+				return fmt.Errorf("failed to update user: %w", err)
+			}
+
+			updatedUsers = append(updatedUsers, updatedUser)
+			result.Users = append(result.Users, BulkSetIsActiveUserResult{
+				User: model.User{
+					UserID:   updatedUser.ExternalID,
+					UserName: updatedUser.Username,
+					IsActive: updatedUser.IsActive,
+				},
+				Changed: user.IsActive != updatedUser.IsActive,
+			})
+		}
+
+		for i, updatedUser := range updatedUsers {
+			if !result.Users[i].Changed {
+				continue
+			}
+
+			result.Users[i].HandOff, err = u.applyActivityChange(ctx, users[i], updatedUser)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return BulkSetIsActiveResult{}, err
+	}
+
+	return result, nil
+}
+
+// bulkTargetUsers возвращает пользователей, к которым применяется массовое изменение, без повторов.
+func (u *UseCase) bulkTargetUsers(
+	ctx context.Context,
+	params BulkSetIsActiveParams,
+) ([]data.User, error) {
+	if params.TeamName != "" {
+		team, err := u.repo.GetTeamByName(ctx, params.TeamName)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by name",
+				zap.Error(err),
+				zap.String("TeamName", params.TeamName))
+
+			return nil, err
+		}
+
+		teamMembers, err := u.repo.GetTeamMembersByTeamID(ctx, team.ID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team members", zap.Error(err))
+
+			return nil, err
+		}
+
+		users := make([]data.User, 0, len(teamMembers))
+		for _, tm := range teamMembers {
+			user, err := u.repo.GetUserByID(ctx, tm.UserID)
+			if err != nil {
+				log.LoggerFromCtx(ctx).Error("error getting user by id",
+					zap.Error(err),
+					zap.String("UserID", tm.UserID.String()))
+
+				return nil, err
+			}
+
+			users = append(users, user)
+		}
+
+		return users, nil
+	}
+
+	seen := make(map[string]struct{}, len(params.UserIDs))
+	users := make([]data.User, 0, len(params.UserIDs))
+
+	for _, userID := range params.UserIDs {
+		if _, ok := seen[userID]; ok {
+			continue
+		}
+
+		seen[userID] = struct{}{}
+
+		user, err := u.repo.GetUserByExternalID(ctx, userID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", userID))
+
+			return nil, err
+		}
+
+		users = append(users, user)
+	}
+
+	return users, nil
+}
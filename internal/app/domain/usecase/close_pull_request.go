@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type ClosePullRequestParams struct {
+	PullRequestID string
+}
+
+type ClosePullRequestResult struct {
+	PR model.PullRequest
+}
+
+// ClosePullRequest закрывает PR без мержа (идемпотентная операция). Ревьюверы остаются
+// назначенными, но закрытый PR не учитывается в их нагрузке.
+func (u *UseCase) ClosePullRequest(
+	ctx context.Context,
+	params ClosePullRequestParams,
+) (ClosePullRequestResult, error) {
+	var result ClosePullRequestResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		pr, err := u.repo.GetPullRequestByExternalID(ctx, params.PullRequestID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting pr by external id", zap.Error(err))
+
+			return err
+		}
+
+		switch pr.Status {
+		case model.PullRequestStatusClosed:
+		case model.PullRequestStatusOpen, model.PullRequestStatusDraft:
+			pr, err = u.setPullRequestStatus(ctx, pr, model.PullRequestStatusClosed)
+			if err != nil {
+				return err
+			}
+		case model.PullRequestStatusMerged:
+			return errors.New(api.ErrPRMerged)
+		default:
+			return errors.New(api.ErrInvalidStatusTransition)
+		}
+
+		result.PR, err = u.pullRequestModel(ctx, pr)
+
+		return err
+	})
+	if err != nil {
+		return ClosePullRequestResult{}, err
+	}
+
+	return result, nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"math/rand"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,6 +20,10 @@ type CreatePullRequestParams struct {
 	PullRequestID   string
 	PullRequestName string
 	AuthorID        string
+	// TeamName - команда, из которой назначаются ревьюверы. По умолчанию - основная команда автора.
+	TeamName string
+	// IsDraft создает PR в статусе DRAFT: ревьюверы назначаются при переводе в OPEN.
+	IsDraft bool
 }
 
 type CreatePullRequestResult struct {
@@ -46,20 +49,39 @@ func (u *UseCase) CreatePullRequest(
 			return errors.New(api.ErrNotFound)
 		}
 
-		teamMembers, err := u.repo.GetTeamMembersByUserID(ctx, author.ID)
+		team, err := u.pullRequestTeam(ctx, author.ID, params.TeamName)
 		if err != nil {
-			return fmt.Errorf("error getting team members by author id: %w", err)
+			return err
 		}
 
-		if len(teamMembers) == 0 {
-			return fmt.Errorf("author has no team")
-		}
+		status := model.PullRequestStatusOpen
+		needMoreReviewers := false
 
-		team, err := u.repo.GetTeamByID(ctx, teamMembers[0].TeamID)
-		if err != nil {
-			log.LoggerFromCtx(ctx).Error("error getting team by ID", zap.Error(err))
+		var reviewers []teamReviewer
+
+		if params.IsDraft {
+			status = model.PullRequestStatusDraft
+		} else {
+			requiredReviewers := u.teamRequiredReviewers(team)
+
+			reviewers, err = u.assignReviewers(ctx, team, author.ID, requiredReviewers)
+			if err != nil {
+				log.LoggerFromCtx(ctx).Error("error assigning reviewers", zap.Error(err))
 
-			return fmt.Errorf("team not found")
+				return fmt.Errorf("failed to assign reviewers: %w", err)
+			}
+
+			reviewerIDs := make([]uuid.UUID, 0, len(reviewers))
+			for _, reviewer := range reviewers {
+				reviewerIDs = append(reviewerIDs, reviewer.User.ID)
+			}
+
+			leadMissing, err := u.leadMissing(ctx, team, reviewerIDs)
+			if err != nil {
+				return err
+			}
+
+			needMoreReviewers = len(reviewers) < requiredReviewers || leadMissing
 		}
 
 		pr := data.PullRequest{
@@ -68,8 +90,9 @@ func (u *UseCase) CreatePullRequest(
 			Title:             params.PullRequestName,
 			Description:       "",
 			AuthorID:          author.ID,
-			Status:            model.PullRequestStatusOpen,
-			NeedMoreReviewers: false,
+			TeamID:            sql.Null[data.TeamInternalID]{V: team.ID, Valid: true},
+			Status:            status,
+			NeedMoreReviewers: needMoreReviewers,
 			CreatedAt:         time.Now(),
 			UpdatedAt:         time.Now(),
 			MergedAt:          sql.NullTime{},
@@ -82,57 +105,22 @@ func (u *UseCase) CreatePullRequest(
 			return fmt.Errorf("failed to create PR: %w", err)
 		}
 
-		reviewers, err := u.assignReviewers(ctx, team.ID, author.ID)
-		if err != nil {
-			log.LoggerFromCtx(ctx).Error("error assigning reviewers", zap.Error(err))
-
-			return fmt.Errorf("failed to assign reviewers: %w", err)
-		}
-
 		var assignedReviewerIDs []string
 
 		for _, reviewer := range reviewers {
-			prReviewer := data.PRReviewer{
-				ID:            uuid.New(),
-				PullRequestID: createdPR.ID,
-				ReviewerID:    reviewer.ID,
-				TeamID:        team.ID,
-				AssignedAt:    time.Now(),
-				ReplacedAt:    sql.NullTime{},
-				IsCurrent:     true,
-			}
-
-			_, err := u.repo.CreatePRReviewer(ctx, prReviewer)
+			err := u.addReviewer(
+				ctx,
+				createdPR.ID,
+				reviewer.User.ID,
+				reviewer.TeamID,
+				sql.Null[data.UserInternalID]{V: author.ID, Valid: true},
+				model.PRReviewerHistoryChangeReasonInitial,
+			)
 			if err != nil {
-				log.LoggerFromCtx(ctx).
-					Error("failed to assign reviewer",
-						zap.Error(err),
-						zap.Any("PRReviewer", prReviewer))
-
-				return fmt.Errorf("failed to assign reviewer: %w", err)
+				return err
 			}
 
-			assignedReviewerIDs = append(assignedReviewerIDs, reviewer.ExternalID)
-
-			history := data.PRReviewerHistory{
-				ID:            uuid.New(),
-				PullRequestID: createdPR.ID,
-				NewReviewerID: reviewer.ID,
-				OldReviewerID: sql.Null[data.UserInternalID]{},
-				ChangedBy:     sql.Null[data.UserInternalID]{V: author.ID, Valid: true},
-				ChangedAt:     time.Now(),
-				Reason:        model.PRReviewerHistoryChangeReasonInitial,
-			}
-
-			_, err = u.repo.CreatePRReviewerHistory(ctx, history)
-			if err != nil {
-				log.LoggerFromCtx(ctx).
-					Error("failed to log reviewer assignment",
-						zap.Error(err),
-						zap.Any("History", history))
-
-				return fmt.Errorf("failed to log reviewer assignment: %w", err)
-			}
+			assignedReviewerIDs = append(assignedReviewerIDs, reviewer.User.ExternalID)
 		}
 
 		result.PR = model.PullRequest{
@@ -143,6 +131,7 @@ func (u *UseCase) CreatePullRequest(
 				Status:          createdPR.Status,
 			},
 			AssignedReviewers: assignedReviewerIDs,
+			NeedMoreReviewers: createdPR.NeedMoreReviewers,
 		}
 
 		return nil
@@ -154,37 +143,49 @@ func (u *UseCase) CreatePullRequest(
 	return result, nil
 }
 
-// assignReviewers выбирает до 2 случайных активных ревьюверов из команды (исключая автора)
+// assignReviewers выбирает до count активных ревьюверов из команды (исключая автора)
+// стратегией и правилом назначения LEAD, настроенными для команды, с учетом правил автора
+// (never_review, prefer). Если кандидатов в команде не хватает, недостающие выбираются
+// из резервных команд.
 func (u *UseCase) assignReviewers(
 	ctx context.Context,
-	teamID, authorID uuid.UUID,
-) ([]data.User, error) {
-	teamMembers, err := u.repo.GetTeamMembersByTeamID(ctx, teamID)
-	if err != nil {
-		return nil, err
-	}
+	team data.Team,
+	authorID uuid.UUID,
+	count int,
+) ([]teamReviewer, error) {
+	return u.selectReviewersWithFallbacks(ctx, team, authorID, []uuid.UUID{authorID}, count, true)
+}
 
-	var availableReviewers []data.User
+// pullRequestTeam возвращает команду, из которой назначаются ревьюверы PR:
+// указанную явно (автор должен в ней состоять) или основную команду автора.
+func (u *UseCase) pullRequestTeam(
+	ctx context.Context,
+	authorID uuid.UUID,
+	teamName string,
+) (data.Team, error) {
+	if teamName == "" {
+		return u.userPrimaryTeam(ctx, authorID)
+	}
 
-	for _, tm := range teamMembers {
-		user, err := u.repo.GetUserByID(ctx, tm.UserID)
-		if err != nil {
-			continue
-		}
+	team, err := u.repo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team by name",
+			zap.Error(err),
+			zap.String("TeamName", teamName))
 
-		if user.IsActive && user.ID != authorID {
-			availableReviewers = append(availableReviewers, user)
-		}
+		return data.Team{}, err
 	}
 
-	count := min(2, len(availableReviewers))
-	if count == 0 {
-		return make([]data.User, 0), nil
+	_, err = u.repo.GetTeamMemberByTeamAndUser(ctx, team.ID, authorID)
+	if errors.Is(err, api.ErrNotFound) {
+		return data.Team{}, errors.New(api.ErrNotTeamMember)
 	}
 
-	rand.Shuffle(len(availableReviewers), func(i, j int) {
-		availableReviewers[i], availableReviewers[j] = availableReviewers[j], availableReviewers[i]
-	})
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team member", zap.Error(err))
+
+		return data.Team{}, err
+	}
 
-	return availableReviewers[:count], nil
+	return team, nil
 }
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type DeleteReviewerRuleParams struct {
+	AuthorID   string
+	ReviewerID string
+}
+
+// DeleteReviewerRule удаляет правило для пары автор-ревьювер.
+func (u *UseCase) DeleteReviewerRule(ctx context.Context, params DeleteReviewerRuleParams) error {
+	return u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		author, err := u.repo.GetUserByExternalID(ctx, params.AuthorID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", params.AuthorID))
+
+			return err
+		}
+
+		reviewer, err := u.repo.GetUserByExternalID(ctx, params.ReviewerID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", params.ReviewerID))
+
+			return err
+		}
+
+		err = u.repo.DeleteReviewerRule(ctx, author.ID, reviewer.ID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error deleting reviewer rule", zap.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type DeleteTeamParams struct {
+	TeamName string
+}
+
+type DeleteTeamResult struct {
+	TeamName string
+	// RemovedMembers - внешние ID пользователей, исключенных из команды.
+	RemovedMembers []string
+	// HandOff - открытые ревью, на которые участники были назначены из этой команды.
+	HandOff ReviewHandOff
+}
+
+// DeleteTeam удаляет команду. Перед удалением все участники исключаются из нее
+// с передачей открытых ревью; история назначений сохраняется.
+func (u *UseCase) DeleteTeam(ctx context.Context, params DeleteTeamParams) (DeleteTeamResult, error) {
+	var result DeleteTeamResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		team, err := u.repo.GetTeamByName(ctx, params.TeamName)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by name",
+				zap.Error(err),
+				zap.String("TeamName", params.TeamName))
+
+			return err
+		}
+
+		teamMembers, err := u.repo.GetTeamMembersByTeamID(ctx, team.ID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team members", zap.Error(err))
+
+			return err
+		}
+
+		result.TeamName = team.Name
+		result.RemovedMembers = make([]string, 0, len(teamMembers))
+
+		users := make([]data.User, 0, len(teamMembers))
+
+		for _, tm := range teamMembers {
+			user, err := u.repo.GetUserByID(ctx, tm.UserID)
+			if err != nil {
+				log.LoggerFromCtx(ctx).Error("error getting user by id", zap.Error(err))
+
+				return err
+			}
+
+			users = append(users, user)
+			result.RemovedMembers = append(result.RemovedMembers, user.ExternalID)
+		}
+
+		// Ревью передаются только после исключения всех участников, поэтому не достаются
+		// участникам удаляемой команды.
+		result.HandOff, err = u.leaveTeamTogether(ctx, users, teamMembers)
+		if err != nil {
+			return err
+		}
+
+		err = u.repo.DeleteTeam(ctx, team.ID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error deleting team", zap.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return DeleteTeamResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type DeleteUnavailabilityPeriodParams struct {
+	PeriodID string
+}
+
+// DeleteUnavailabilityPeriod удаляет период недоступности.
+// Переданные другим ревьюверам ревью пользователю не возвращаются.
+func (u *UseCase) DeleteUnavailabilityPeriod(
+	ctx context.Context,
+	params DeleteUnavailabilityPeriodParams,
+) error {
+	return u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		period, err := u.getUnavailabilityPeriod(ctx, params.PeriodID)
+		if err != nil {
+			return err
+		}
+
+		err = u.repo.DeleteUnavailabilityPeriod(ctx, period.ID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error deleting unavailability period", zap.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+}
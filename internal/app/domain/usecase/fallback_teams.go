@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// teamReviewer - выбранный ревьювер и команда, из которой он назначается.
+type teamReviewer struct {
+	User   data.User
+	TeamID uuid.UUID
+}
+
+// selectReviewersWithFallbacks подбирает ревьюверов для PR автора из команды (см. selectReviewers),
+// а если кандидатов не хватает - по очереди из резервных команд. Правило LEAD
+// домашней команды к резервным не применяется, действует только их собственное.
+// Правила автора never_review и prefer учитываются во всех командах.
+func (u *UseCase) selectReviewersWithFallbacks(
+	ctx context.Context,
+	team data.Team,
+	authorID uuid.UUID,
+	exclude []uuid.UUID,
+	count int,
+	needLead bool,
+) ([]teamReviewer, error) {
+	if count <= 0 && !needLead {
+		return make([]teamReviewer, 0), nil
+	}
+
+	count = max(0, count)
+
+	never, prefer, err := u.authorReviewerRules(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	exclude = append(slices.Clone(exclude), never...)
+
+	home, err := u.selectReviewers(ctx, team, exclude, prefer, count, needLead)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make([]teamReviewer, 0, count)
+	for _, user := range home {
+		selected = append(selected, teamReviewer{User: user, TeamID: team.ID})
+	}
+
+	if len(selected) >= count {
+		return selected, nil
+	}
+
+	fallbackTeams, err := u.teamFallbacks(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reviewer := range selected {
+		exclude = append(exclude, reviewer.User.ID)
+	}
+
+	for _, fallbackTeam := range fallbackTeams {
+		users, err := u.selectReviewers(
+			ctx,
+			fallbackTeam,
+			exclude,
+			prefer,
+			count-len(selected),
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range users {
+			selected = append(selected, teamReviewer{User: user, TeamID: fallbackTeam.ID})
+			exclude = append(exclude, user.ID)
+		}
+
+		if len(selected) >= count {
+			break
+		}
+	}
+
+	return selected, nil
+}
+
+// teamFallbacks возвращает резервные команды в порядке обращения к ним.
+func (u *UseCase) teamFallbacks(ctx context.Context, teamID uuid.UUID) ([]data.Team, error) {
+	fallbacks, err := u.repo.GetTeamFallbacks(ctx, teamID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team fallbacks",
+			zap.Error(err),
+			zap.String("TeamID", teamID.String()))
+
+		return nil, err
+	}
+
+	teams := make([]data.Team, 0, len(fallbacks))
+
+	for _, fallback := range fallbacks {
+		team, err := u.repo.GetTeamByID(ctx, fallback.FallbackTeamID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by id",
+				zap.Error(err),
+				zap.String("TeamID", fallback.FallbackTeamID.String()))
+
+			return nil, err
+		}
+
+		teams = append(teams, team)
+	}
+
+	return teams, nil
+}
+
+// teamFallbackNames возвращает названия резервных команд в порядке обращения к ним.
+func (u *UseCase) teamFallbackNames(ctx context.Context, teamID uuid.UUID) ([]string, error) {
+	teams, err := u.teamFallbacks(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(teams))
+	for _, team := range teams {
+		names = append(names, team.Name)
+	}
+
+	return names, nil
+}
+
+// setTeamFallbacks заменяет резервные команды команды списком названий (порядок важен).
+func (u *UseCase) setTeamFallbacks(ctx context.Context, team data.Team, teamNames []string) error {
+	fallbacks := make([]data.TeamFallback, 0, len(teamNames))
+	seen := make(map[uuid.UUID]struct{}, len(teamNames))
+
+	for _, teamName := range teamNames {
+		fallbackTeam, err := u.repo.GetTeamByName(ctx, teamName)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by name",
+				zap.Error(err),
+				zap.String("TeamName", teamName))
+
+			return err
+		}
+
+		if fallbackTeam.ID == team.ID {
+			return errors.New(api.ErrInvalidFallbackTeam)
+		}
+
+		if _, ok := seen[fallbackTeam.ID]; ok {
+			continue
+		}
+
+		seen[fallbackTeam.ID] = struct{}{}
+
+		fallbacks = append(fallbacks, data.TeamFallback{
+			TeamID:         team.ID,
+			FallbackTeamID: fallbackTeam.ID,
+			Position:       len(fallbacks),
+			CreatedAt:      time.Now(),
+		})
+	}
+
+	err := u.repo.ReplaceTeamFallbacks(ctx, team.ID, fallbacks)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error replacing team fallbacks", zap.Error(err))
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type GetPullRequestParams struct {
+	PullRequestID string
+}
+
+type GetPullRequestResult struct {
+	PR model.PullRequestDetails
+}
+
+func (u *UseCase) GetPullRequest(
+	ctx context.Context,
+	params GetPullRequestParams,
+) (GetPullRequestResult, error) {
+	pr, err := u.repo.GetPullRequestByExternalID(ctx, params.PullRequestID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting pr by external id",
+			zap.Error(err),
+			zap.String("PullRequestID", params.PullRequestID))
+
+		return GetPullRequestResult{}, err
+	}
+
+	details, err := u.pullRequestDetails(ctx, pr, make(map[uuid.UUID]string))
+	if err != nil {
+		return GetPullRequestResult{}, err
+	}
+
+	return GetPullRequestResult{PR: details}, nil
+}
+
+// pullRequestDetails возвращает PR с командой и временем создания и мержа.
+// teamNames - кэш названий команд между вызовами.
+func (u *UseCase) pullRequestDetails(
+	ctx context.Context,
+	pr data.PullRequest,
+	teamNames map[uuid.UUID]string,
+) (model.PullRequestDetails, error) {
+	pullRequest, err := u.pullRequestModel(ctx, pr)
+	if err != nil {
+		return model.PullRequestDetails{}, err
+	}
+
+	details := model.PullRequestDetails{
+		PullRequest: pullRequest,
+		CreatedAt:   pr.CreatedAt,
+		MergedAt:    pr.MergedAt.Time,
+	}
+
+	if pr.TeamID.Valid {
+		teamName, ok := teamNames[pr.TeamID.V]
+		if !ok {
+			team, err := u.repo.GetTeamByID(ctx, pr.TeamID.V)
+			if err != nil {
+				log.LoggerFromCtx(ctx).Error("error getting team by id", zap.Error(err))
+
+				return model.PullRequestDetails{}, err
+			}
+
+			teamName = team.Name
+			teamNames[pr.TeamID.V] = teamName
+		}
+
+		details.TeamName = teamName
+	}
+
+	return details, nil
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type GetPullRequestHistoryParams struct {
+	PullRequestID string
+}
+
+type GetPullRequestHistoryResult struct {
+	PullRequestID string
+	Entries       []model.PRReviewerHistoryEntry
+}
+
+// GetPullRequestHistory возвращает историю назначений ревьюверов PR в хронологическом порядке.
+func (u *UseCase) GetPullRequestHistory(
+	ctx context.Context,
+	params GetPullRequestHistoryParams,
+) (GetPullRequestHistoryResult, error) {
+	pr, err := u.repo.GetPullRequestByExternalID(ctx, params.PullRequestID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting pr by external id",
+			zap.Error(err),
+			zap.String("PullRequestID", params.PullRequestID))
+
+		return GetPullRequestHistoryResult{}, err
+	}
+
+	history, err := u.repo.GetPRReviewerHistory(ctx, pr.ID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting pr reviewer history", zap.Error(err))
+
+		return GetPullRequestHistoryResult{}, fmt.Errorf("failed to get history: %w", err)
+	}
+
+	externalIDs := make(map[uuid.UUID]string)
+
+	externalID := func(userID sql.Null[data.UserInternalID]) (string, error) {
+		if !userID.Valid {
+			return "", nil
+		}
+
+		if id, ok := externalIDs[userID.V]; ok {
+			return id, nil
+		}
+
+		user, err := u.repo.GetUserByID(ctx, userID.V)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by id",
+				zap.Error(err),
+				zap.String("UserID", userID.V.String()))
+
+			return "", fmt.Errorf("failed to get user: %w", err)
+		}
+
+		externalIDs[userID.V] = user.ExternalID
+
+		return user.ExternalID, nil
+	}
+
+	result := GetPullRequestHistoryResult{
+		PullRequestID: pr.ExternalID,
+		Entries:       make([]model.PRReviewerHistoryEntry, 0, len(history)),
+	}
+
+	for _, h := range history {
+		oldReviewerID, err := externalID(h.OldReviewerID)
+		if err != nil {
+			return GetPullRequestHistoryResult{}, err
+		}
+
+		newReviewerID, err := externalID(h.NewReviewerID)
+		if err != nil {
+			return GetPullRequestHistoryResult{}, err
+		}
+
+		changedBy, err := externalID(h.ChangedBy)
+		if err != nil {
+			return GetPullRequestHistoryResult{}, err
+		}
+
+		result.Entries = append(result.Entries, model.PRReviewerHistoryEntry{
+			OldReviewerID: oldReviewerID,
+			NewReviewerID: newReviewerID,
+			ChangedBy:     changedBy,
+			Reason:        h.Reason,
+			Comment:       h.Comment.String,
+			ChangedAt:     h.ChangedAt,
+		})
+	}
+
+	return result, nil
+}
@@ -2,58 +2,107 @@ package usecase
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
 
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
 	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
 )
 
 type GetReviewParams struct {
 	UserID string
+	// Status - статус PR, по умолчанию OPEN.
+	Status model.PullRequestStatus
+	// Cursor - NextCursor предыдущей страницы.
+	Cursor string
+	// Limit - размер страницы, по умолчанию defaultPageLimit.
+	Limit int
 }
 
 type GetReviewResult struct {
 	UserID       string
-	PullRequests []model.PullRequestShort
+	PullRequests []model.ReviewAssignment
+	// NextCursor - курсор следующей страницы (пусто, если страница последняя).
+	NextCursor string
 }
 
+// GetReview возвращает PR, на которые пользователь назначен ревьювером, по возрастанию времени назначения.
 func (u *UseCase) GetReview(
 	ctx context.Context,
 	params GetReviewParams,
 ) (GetReviewResult, error) {
-	user, err := u.repo.GetUserByExternalID(ctx, params.UserID)
+	limit, err := pageLimit(params.Limit)
 	if err != nil {
-		return GetReviewResult{}, fmt.Errorf("user not found")
+		return GetReviewResult{}, err
 	}
 
-	reviewers, err := u.repo.GetUserAssignedPRs(ctx, user.ID)
+	status := params.Status
+	if status == "" {
+		status = model.PullRequestStatusOpen
+	}
+
+	if !isKnownPullRequestStatus(status) {
+		return GetReviewResult{}, errors.New(api.ErrUnknownStatus)
+	}
+
+	user, err := u.repo.GetUserByExternalID(ctx, params.UserID)
 	if err != nil {
-		return GetReviewResult{}, fmt.Errorf("failed to get assigned PRs: %w", err)
+		log.LoggerFromCtx(ctx).Error("error getting user by external id",
+			zap.Error(err),
+			zap.String("UserID", params.UserID))
+
+		return GetReviewResult{}, err
 	}
 
-	var pullRequests []model.PullRequestShort
+	// Запрашиваем на одно назначение больше, чтобы понять, есть ли следующая страница
+	filter := data.ReviewAssignmentFilter{
+		ReviewerID: user.ID,
+		Status:     sql.Null[string]{V: status, Valid: true},
+		Limit:      limit + 1,
+	}
 
-	for _, reviewer := range reviewers {
-		pr, err := u.repo.GetPullRequestByID(ctx, reviewer.PullRequestID)
+	if params.Cursor != "" {
+		assignedAt, id, err := decodeCursor(params.Cursor)
 		if err != nil {
-			continue
+			return GetReviewResult{}, err
 		}
 
-		author, err := u.repo.GetUserByID(ctx, pr.AuthorID)
-		if err != nil {
-			continue
+		filter.After = sql.Null[data.ReviewAssignmentCursor]{
+			V:     data.ReviewAssignmentCursor{AssignedAt: assignedAt, ID: id},
+			Valid: true,
 		}
+	}
 
-		pullRequests = append(pullRequests, model.PullRequestShort{
-			PullRequestID:   pr.ExternalID,
-			PullRequestName: pr.Title,
-			AuthorID:        author.ExternalID,
-			Status:          pr.Status,
-		})
+	assignments, err := u.repo.GetReviewAssignments(ctx, filter)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting review assignments", zap.Error(err))
+
+		return GetReviewResult{}, err
 	}
 
-	result := GetReviewResult{
-		UserID:       user.ExternalID,
-		PullRequests: pullRequests,
+	result := GetReviewResult{UserID: user.ExternalID}
+
+	if len(assignments) > limit {
+		assignments = assignments[:limit]
+		last := assignments[len(assignments)-1]
+		result.NextCursor = encodeCursor(last.AssignedAt, last.ID)
+	}
+
+	result.PullRequests = make([]model.ReviewAssignment, 0, len(assignments))
+	for _, assignment := range assignments {
+		result.PullRequests = append(result.PullRequests, model.ReviewAssignment{
+			PullRequestShort: model.PullRequestShort{
+				PullRequestID:   assignment.PullRequestExternalID,
+				PullRequestName: assignment.PullRequestTitle,
+				AuthorID:        assignment.AuthorExternalID,
+				Status:          assignment.PullRequestStatus,
+			},
+			AssignedAt: assignment.AssignedAt,
+		})
 	}
 
 	return result, nil
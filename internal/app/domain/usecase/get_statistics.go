@@ -16,6 +16,8 @@ type GetStatisticsResult struct {
 	TotalPRs        int64                 `json:"total_prs"`
 	OpenPRs         int64                 `json:"open_prs"`
 	MergedPRs       int64                 `json:"merged_prs"`
+	ClosedPRs       int64                 `json:"closed_prs"`
+	DraftPRs        int64                 `json:"draft_prs"`
 	UserAssignments []UserAssignmentStats `json:"user_assignments"`
 	TeamStats       []TeamStatistics      `json:"team_stats"`
 	ReviewerLoad    []ReviewerLoadStats   `json:"reviewer_load"`
@@ -57,6 +59,8 @@ func (u *UseCase) GetStatistics(
 	result.TotalPRs = int64(len(allPRs))
 	result.OpenPRs = u.countPRsByStatus(allPRs, model.PullRequestStatusOpen)
 	result.MergedPRs = u.countPRsByStatus(allPRs, model.PullRequestStatusMerged)
+	result.ClosedPRs = u.countPRsByStatus(allPRs, model.PullRequestStatusClosed)
+	result.DraftPRs = u.countPRsByStatus(allPRs, model.PullRequestStatusDraft)
 
 	userStats, err := u.calculateUserStatistics(ctx)
 	if err != nil {
@@ -65,7 +69,7 @@ func (u *UseCase) GetStatistics(
 
 	result.UserAssignments = userStats
 
-	teamStats, err := u.calculateTeamStatistics(ctx, allPRs)
+	teamStats, err := u.calculateTeamStatistics(ctx)
 	if err != nil {
 		return GetStatisticsResult{}, fmt.Errorf("failed to calculate team stats: %w", err)
 	}
@@ -83,17 +87,23 @@ func (u *UseCase) GetStatistics(
 }
 
 func (u *UseCase) getAllPullRequests(ctx context.Context) ([]data.PullRequest, error) {
-	openPRs, err := u.repo.GetPullRequestsByStatus(ctx, model.PullRequestStatusOpen)
-	if err != nil {
-		return nil, err
-	}
+	var prs []data.PullRequest
+
+	for _, status := range []model.PullRequestStatus{
+		model.PullRequestStatusOpen,
+		model.PullRequestStatusMerged,
+		model.PullRequestStatusClosed,
+		model.PullRequestStatusDraft,
+	} {
+		statusPRs, err := u.repo.GetPullRequestsByStatus(ctx, status)
+		if err != nil {
+			return nil, err
+		}
 
-	mergedPRs, err := u.repo.GetPullRequestsByStatus(ctx, model.PullRequestStatusMerged)
-	if err != nil {
-		return nil, err
+		prs = append(prs, statusPRs...)
 	}
 
-	return append(openPRs, mergedPRs...), nil
+	return prs, nil
 }
 
 func (u *UseCase) countPRsByStatus(prs []data.PullRequest, status string) int64 {
@@ -140,13 +150,9 @@ func (u *UseCase) calculateUserStatistics(
 
 		var teamName *string
 
-		teamMembers, err := u.repo.GetTeamMembersByTeamID(ctx, user.ID)
-
-		if err == nil && len(teamMembers) > 0 {
-			team, err := u.repo.GetTeamByID(ctx, teamMembers[0].TeamID)
-			if err == nil {
-				teamName = &team.Name
-			}
+		team, err := u.userPrimaryTeam(ctx, user.ID)
+		if err == nil {
+			teamName = &team.Name
 		}
 
 		userStats = append(userStats, UserAssignmentStats{
@@ -162,37 +168,27 @@ func (u *UseCase) calculateUserStatistics(
 	return userStats, nil
 }
 
-func (u *UseCase) calculateTeamStatistics(
-	ctx context.Context,
-	prs []data.PullRequest,
-) ([]TeamStatistics, error) {
+// calculateTeamStatistics считает PR каждой команды по команде PR (pull_requests.team_id),
+// поэтому PR автора из нескольких команд учитывается один раз.
+func (u *UseCase) calculateTeamStatistics(ctx context.Context) ([]TeamStatistics, error) {
 	teams, err := u.getAllTeams(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	var teamStats []TeamStatistics
-
-	for _, team := range teams {
-		var teamPRs, openPRs int64
-
-		for _, pr := range prs {
-			author, err := u.repo.GetUserByID(ctx, pr.AuthorID)
-			if err != nil {
-				continue
-			}
-
-			isInTeam, err := u.isUserInTeam(ctx, author.ID, team.ID)
+	counts, err := u.repo.CountPullRequestsByTeam(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-			if err == nil && isInTeam {
-				teamPRs++
+	countByTeam := make(map[uuid.UUID]data.TeamPullRequestCount, len(counts))
+	for _, count := range counts {
+		countByTeam[count.TeamID] = count
+	}
 
-				if pr.Status == model.PullRequestStatusOpen {
-					openPRs++
-				}
-			}
-		}
+	var teamStats []TeamStatistics
 
+	for _, team := range teams {
 		totalReviews, err := u.getTeamReviewAssignmentsCount(ctx, team.ID)
 		if err != nil {
 			totalReviews = 0
@@ -200,8 +196,8 @@ func (u *UseCase) calculateTeamStatistics(
 
 		teamStats = append(teamStats, TeamStatistics{
 			TeamName:     team.Name,
-			TotalPRs:     teamPRs,
-			OpenPRs:      openPRs,
+			TotalPRs:     countByTeam[team.ID].TotalPRs,
+			OpenPRs:      countByTeam[team.ID].OpenPRs,
 			TotalReviews: totalReviews,
 		})
 	}
@@ -244,9 +240,12 @@ func (u *UseCase) calculateReviewerLoad(ctx context.Context) ([]ReviewerLoadStat
 	return reviewerLoad, nil
 }
 
+// getAllUsers возвращает участников всех команд; пользователь из нескольких команд входит один раз.
 func (u *UseCase) getAllUsers(ctx context.Context) ([]data.User, error) {
 	var users []data.User
 
+	seen := make(map[uuid.UUID]bool)
+
 	teams, err := u.getAllTeams(ctx)
 	if err != nil {
 		return nil, err
@@ -259,8 +258,13 @@ func (u *UseCase) getAllUsers(ctx context.Context) ([]data.User, error) {
 		}
 
 		for _, member := range members {
+			if seen[member.UserID] {
+				continue
+			}
+
 			user, err := u.repo.GetUserByID(ctx, member.UserID)
 			if err == nil {
+				seen[member.UserID] = true
 				users = append(users, user)
 			}
 		}
@@ -278,31 +282,11 @@ func (u *UseCase) getAllTeams(ctx context.Context) ([]data.Team, error) {
 	return teams, nil
 }
 
-func (u *UseCase) isUserInTeam(ctx context.Context, userID, teamID uuid.UUID) (bool, error) {
-	_, err := u.repo.GetTeamMemberByTeamAndUser(ctx, teamID, userID)
-	if err != nil {
-		return false, err
-	}
-	return true, nil
-}
-
+// getTeamReviewAssignmentsCount возвращает количество текущих назначений, сделанных из команды
+// (включая назначения ее участников на PR других команд в качестве резервной команды).
 func (u *UseCase) getTeamReviewAssignmentsCount(
 	ctx context.Context,
 	teamID uuid.UUID,
 ) (int64, error) {
-	teamMembers, err := u.repo.GetTeamMembersByTeamID(ctx, teamID)
-	if err != nil {
-		return 0, err
-	}
-
-	var total int64
-
-	for _, member := range teamMembers {
-		reviews, err := u.repo.GetUserAssignedPRs(ctx, member.UserID)
-		if err == nil {
-			total += int64(len(reviews))
-		}
-	}
-
-	return total, nil
+	return u.repo.CountTeamAssignments(ctx, teamID)
 }
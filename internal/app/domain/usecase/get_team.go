@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
+
+	"pr-reviewer-assign-service/internal/app/data"
 	"pr-reviewer-assign-service/internal/app/domain/model"
 )
 
@@ -21,30 +24,62 @@ func (u *UseCase) GetTeam(ctx context.Context, params GetTeamParams) (GetTeamRes
 		return GetTeamResult{}, err
 	}
 
-	teamMembers, err := u.repo.GetTeamMembersByTeamID(ctx, team.ID)
+	members, err := u.teamMembers(ctx, team.ID)
+	if err != nil {
+		return GetTeamResult{}, err
+	}
+
+	teamModel, err := u.teamModel(ctx, team, members)
+	if err != nil {
+		return GetTeamResult{}, err
+	}
+
+	return GetTeamResult{Team: teamModel}, nil
+}
+
+// teamModel возвращает команду с настройками, резервными командами и участниками members.
+func (u *UseCase) teamModel(
+	ctx context.Context,
+	team data.Team,
+	members []model.TeamMember,
+) (model.Team, error) {
+	fallbackTeams, err := u.teamFallbackNames(ctx, team.ID)
 	if err != nil {
-		return GetTeamResult{}, fmt.Errorf("failed to get team members: %w", err)
+		return model.Team{}, err
 	}
 
-	var members []model.TeamMember
+	return model.Team{
+		TeamName:           team.Name,
+		ReviewerStrategy:   u.teamStrategy(team),
+		RequiredReviewers:  u.teamRequiredReviewers(team),
+		MaxOpenReviews:     u.teamMaxOpenReviews(team),
+		PreferWorkingHours: u.teamPreferWorkingHours(team),
+		LeadRule:           teamLeadRule(team),
+		FallbackTeams:      fallbackTeams,
+		Members:            members,
+	}, nil
+}
+
+// teamMembers возвращает участников команды.
+func (u *UseCase) teamMembers(ctx context.Context, teamID uuid.UUID) ([]model.TeamMember, error) {
+	teamMembers, err := u.repo.GetTeamMembersByTeamID(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team members: %w", err)
+	}
+
+	members := make([]model.TeamMember, 0, len(teamMembers))
 	for _, tm := range teamMembers {
 		user, err := u.repo.GetUserByID(ctx, tm.UserID)
 		if err != nil {
-			return GetTeamResult{}, fmt.Errorf("failed to get user: %w", err)
+			return nil, fmt.Errorf("failed to get user: %w", err)
 		}
 		members = append(members, model.TeamMember{
 			UserID:   user.ExternalID,
 			Username: user.Username,
 			IsActive: user.IsActive,
+			Role:     tm.Role,
 		})
 	}
 
-	result := GetTeamResult{
-		Team: model.Team{
-			TeamName: team.Name,
-			Members:  members,
-		},
-	}
-
-	return result, nil
+	return members, nil
 }
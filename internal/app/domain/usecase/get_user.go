@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type GetUserParams struct {
+	UserID string
+}
+
+type GetUserResult struct {
+	User  model.User
+	Teams []model.UserTeam
+}
+
+func (u *UseCase) GetUser(
+	ctx context.Context,
+	params GetUserParams,
+) (GetUserResult, error) {
+	user, err := u.repo.GetUserByExternalID(ctx, params.UserID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting user by external id",
+			zap.Error(err),
+			zap.String("UserID", params.UserID))
+
+		return GetUserResult{}, err
+	}
+
+	teams, err := u.userTeams(ctx, user.ID)
+	if err != nil {
+		return GetUserResult{}, err
+	}
+
+	return GetUserResult{
+		User:  userWithPrimaryTeam(user, teams),
+		Teams: teams,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type GetUserLoadParams struct {
+	UserID string
+}
+
+type GetUserLoadResult struct {
+	Load model.UserLoad
+}
+
+// GetUserLoad возвращает количество текущих назначений пользователя на открытые PR
+// и его лимит назначений. Лимит команды берется из основной команды пользователя.
+func (u *UseCase) GetUserLoad(
+	ctx context.Context,
+	params GetUserLoadParams,
+) (GetUserLoadResult, error) {
+	user, err := u.repo.GetUserByExternalID(ctx, params.UserID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting user by external id",
+			zap.Error(err),
+			zap.String("UserID", params.UserID))
+
+		return GetUserLoadResult{}, err
+	}
+
+	teamMembers, err := u.repo.GetTeamMembersByUserID(ctx, user.ID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team members", zap.Error(err))
+
+		return GetUserLoadResult{}, err
+	}
+
+	var primaryTeam data.Team
+
+	for _, tm := range teamMembers {
+		if !tm.IsPrimary {
+			continue
+		}
+
+		primaryTeam, err = u.repo.GetTeamByID(ctx, tm.TeamID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by id", zap.Error(err))
+
+			return GetUserLoadResult{}, err
+		}
+	}
+
+	openReviews, err := u.reviewerOpenReviews(ctx, user.ID)
+	if err != nil {
+		return GetUserLoadResult{}, err
+	}
+
+	capacity := u.reviewerCapacity(user, primaryTeam)
+
+	return GetUserLoadResult{Load: model.UserLoad{
+		UserID:         user.ExternalID,
+		OpenReviews:    openReviews,
+		MaxOpenReviews: capacity,
+		AtCapacity:     atCapacity(capacity, openReviews),
+	}}, nil
+}
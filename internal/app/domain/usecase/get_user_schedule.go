@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type GetUserScheduleParams struct {
+	UserID string
+}
+
+type GetUserScheduleResult struct {
+	Schedule model.UserSchedule
+}
+
+// GetUserSchedule возвращает часовой пояс и рабочие часы пользователя.
+func (u *UseCase) GetUserSchedule(
+	ctx context.Context,
+	params GetUserScheduleParams,
+) (GetUserScheduleResult, error) {
+	user, err := u.repo.GetUserByExternalID(ctx, params.UserID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting user by external id",
+			zap.Error(err),
+			zap.String("UserID", params.UserID))
+
+		return GetUserScheduleResult{}, err
+	}
+
+	hours, err := u.repo.GetWorkingHours(ctx, []uuid.UUID{user.ID})
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting working hours", zap.Error(err))
+
+		return GetUserScheduleResult{}, err
+	}
+
+	return GetUserScheduleResult{Schedule: toUserSchedule(user, hours, time.Now())}, nil
+}
@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// ReviewHandOff - результат передачи открытых ревью пользователя другим участникам команды.
+type ReviewHandOff struct {
+	// Reassigned - PR, на которые удалось назначить замену.
+	Reassigned []model.ReassignedReview
+	// NoCandidate - внешние ID PR, с которых пользователь снят без замены.
+	NoCandidate []string
+}
+
+// merge добавляет к результату результат другой передачи ревью.
+func (h *ReviewHandOff) merge(other ReviewHandOff) {
+	h.Reassigned = append(h.Reassigned, other.Reassigned...)
+	h.NoCandidate = append(h.NoCandidate, other.NoCandidate...)
+}
+
+// handOffReviews передает все открытые ревью пользователя другим участникам команды,
+// из которой он был назначен, а если там никого не осталось - участникам других команд автора PR.
+// Если замены нет, пользователь снимается с PR, а PR помечается флагом need_more_reviewers.
+func (u *UseCase) handOffReviews(
+	ctx context.Context,
+	user data.User,
+	reason model.PRReviewerHistoryChangeReason,
+) (ReviewHandOff, error) {
+	assignments, err := u.repo.GetUserAssignedPRs(ctx, user.ID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting assigned PRs", zap.Error(err))
+
+		return ReviewHandOff{}, fmt.Errorf("failed to get assigned PRs: %w", err)
+	}
+
+	return u.handOffAssignments(ctx, user, assignments, reason)
+}
+
+// handOffTeamReviews передает только те открытые ревью пользователя,
+// на которые он был назначен из указанной команды.
+func (u *UseCase) handOffTeamReviews(
+	ctx context.Context,
+	user data.User,
+	teamID uuid.UUID,
+	reason model.PRReviewerHistoryChangeReason,
+) (ReviewHandOff, error) {
+	assignments, err := u.repo.GetUserAssignedPRs(ctx, user.ID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting assigned PRs", zap.Error(err))
+
+		return ReviewHandOff{}, fmt.Errorf("failed to get assigned PRs: %w", err)
+	}
+
+	teamAssignments := make([]data.PRReviewer, 0, len(assignments))
+	for _, assignment := range assignments {
+		if assignment.TeamID == teamID {
+			teamAssignments = append(teamAssignments, assignment)
+		}
+	}
+
+	return u.handOffAssignments(ctx, user, teamAssignments, reason)
+}
+
+func (u *UseCase) handOffAssignments(
+	ctx context.Context,
+	user data.User,
+	assignments []data.PRReviewer,
+	reason model.PRReviewerHistoryChangeReason,
+) (ReviewHandOff, error) {
+	var handOff ReviewHandOff
+
+	for _, assignment := range assignments {
+		pr, err := u.repo.GetPullRequestByID(ctx, assignment.PullRequestID)
+		if err != nil {
+			return ReviewHandOff{}, fmt.Errorf("failed to get PR: %w", err)
+		}
+
+		if pr.Status != model.PullRequestStatusOpen {
+			continue
+		}
+
+		reviewers, err := u.repo.GetCurrentReviewers(ctx, pr.ID)
+		if err != nil {
+			return ReviewHandOff{}, fmt.Errorf("failed to get reviewers: %w", err)
+		}
+
+		exclude := []uuid.UUID{pr.AuthorID}
+		for _, r := range reviewers {
+			exclude = append(exclude, r.ReviewerID)
+		}
+
+		remaining := reviewerIDsWithout(reviewers, user.ID)
+
+		newReviewer, newReviewerTeamID, err := u.findHandOffReviewer(
+			ctx,
+			pr,
+			assignment.TeamID,
+			exclude,
+			remaining,
+		)
+		if errors.Is(err, api.ErrNoCandidate) {
+			err = u.removeReviewer(ctx, assignment, sql.Null[data.UserInternalID]{}, reason)
+			if err != nil {
+				return ReviewHandOff{}, err
+			}
+
+			pr.NeedMoreReviewers = true
+
+			_, err = u.repo.UpdatePullRequest(ctx, pr)
+			if err != nil {
+				log.LoggerFromCtx(ctx).Error("error updating pr", zap.Error(err))
+
+				return ReviewHandOff{}, fmt.Errorf("failed to update PR: %w", err)
+			}
+
+			handOff.NoCandidate = append(handOff.NoCandidate, pr.ExternalID)
+
+			continue
+		}
+
+		if err != nil {
+			return ReviewHandOff{}, err
+		}
+
+		err = u.replaceReviewer(ctx, pr.ID, user.ID, newReviewer.ID, newReviewerTeamID)
+		if err != nil {
+			return ReviewHandOff{}, fmt.Errorf("failed to replace reviewer: %w", err)
+		}
+
+		err = u.logReviewerChange(
+			ctx,
+			pr.ID,
+			sql.Null[data.UserInternalID]{V: user.ID, Valid: true},
+			sql.Null[data.UserInternalID]{V: newReviewer.ID, Valid: true},
+			sql.Null[data.UserInternalID]{},
+			reason,
+		)
+		if err != nil {
+			return ReviewHandOff{}, err
+		}
+
+		err = u.markIfLeadMissing(ctx, pr, assignment.TeamID, append(remaining, newReviewer.ID))
+		if err != nil {
+			return ReviewHandOff{}, err
+		}
+
+		handOff.Reassigned = append(handOff.Reassigned, model.ReassignedReview{
+			PullRequestID: pr.ExternalID,
+			OldReviewerID: user.ExternalID,
+			NewReviewerID: newReviewer.ExternalID,
+		})
+	}
+
+	return handOff, nil
+}
+
+// findHandOffReviewer ищет замену сначала в команде, из которой был назначен ревьювер,
+// и ее резервных командах, затем в остальных командах автора PR. Возвращает ревьювера и команду, из которой он выбран.
+func (u *UseCase) findHandOffReviewer(
+	ctx context.Context,
+	pr data.PullRequest,
+	teamID uuid.UUID,
+	exclude []uuid.UUID,
+	remaining []uuid.UUID,
+) (data.User, uuid.UUID, error) {
+	reviewer, reviewerTeamID, err := u.findReplacementReviewer(
+		ctx,
+		teamID,
+		pr.AuthorID,
+		exclude,
+		remaining,
+	)
+	if !errors.Is(err, api.ErrNoCandidate) {
+		return reviewer, reviewerTeamID, err
+	}
+
+	authorTeams, err := u.repo.GetTeamMembersByUserID(ctx, pr.AuthorID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting author teams", zap.Error(err))
+
+		return data.User{}, uuid.Nil, fmt.Errorf("failed to get author teams: %w", err)
+	}
+
+	for _, tm := range authorTeams {
+		if tm.TeamID == teamID {
+			continue
+		}
+
+		reviewer, reviewerTeamID, err = u.findReplacementReviewer(
+			ctx,
+			tm.TeamID,
+			pr.AuthorID,
+			exclude,
+			remaining,
+		)
+		if errors.Is(err, api.ErrNoCandidate) {
+			continue
+		}
+
+		return reviewer, reviewerTeamID, err
+	}
+
+	return data.User{}, uuid.Nil, errors.New(api.ErrNoCandidate)
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	goerrors "errors"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type HandOffUnavailableReviewsResult struct {
+	// Periods - количество обработанных периодов недоступности.
+	Periods int
+	HandOff ReviewHandOff
+}
+
+// HandOffUnavailableReviews передает открытые ревью пользователей, у которых начался период
+// недоступности, другим ревьюверам. Каждый период обрабатывается один раз в отдельной транзакции:
+// период, который не удалось обработать, пропускается до следующего запуска, а ошибки всех таких
+// периодов возвращаются вместе с результатом по остальным.
+func (u *UseCase) HandOffUnavailableReviews(ctx context.Context) (HandOffUnavailableReviewsResult, error) {
+	periods, err := u.repo.GetPendingHandOffPeriods(ctx, time.Now())
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting pending hand off periods", zap.Error(err))
+
+		return HandOffUnavailableReviewsResult{}, err
+	}
+
+	var (
+		result HandOffUnavailableReviewsResult
+		errs   []error
+	)
+
+	for _, period := range periods {
+		err = u.txMan.Transactional(ctx, func(ctx context.Context) error {
+			user, err := u.repo.GetUserByID(ctx, period.UserID)
+			if err != nil {
+				log.LoggerFromCtx(ctx).Error("error getting user by id", zap.Error(err))
+
+				return err
+			}
+
+			handOff, err := u.handOffReviews(ctx, user, model.PRReviewerHistoryChangeReasonUnavailable)
+			if err != nil {
+				return err
+			}
+
+			period.HandedOffAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+			_, err = u.repo.UpdateUnavailabilityPeriod(ctx, period)
+			if err != nil {
+				log.LoggerFromCtx(ctx).Error("error updating unavailability period", zap.Error(err))
+
+				return err
+			}
+
+			result.Periods++
+			result.HandOff.merge(handOff)
+
+			return nil
+		})
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error handing off reviews for unavailability period",
+				zap.Error(err),
+				zap.String("PeriodID", period.ID.String()))
+
+			errs = append(errs, err)
+		}
+	}
+
+	return result, goerrors.Join(errs...)
+}
@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/ical"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// maxUnavailabilityReasonLength - длина колонки user_unavailability.reason.
+const maxUnavailabilityReasonLength = 255
+
+type ImportUnavailabilityParams struct {
+	// Calendar - документ iCalendar (.ics).
+	Calendar io.Reader
+}
+
+type ImportUnavailabilityResult struct {
+	Created int
+	Updated int
+	Deleted int
+	// SkippedEvents - события без UID, с некорректными датами или неизвестным часовым поясом,
+	// а также переопределения отдельных повторений (RECURRENCE-ID), которые не импортируются.
+	SkippedEvents int
+	// UnmatchedEmails - адреса участников событий, для которых не найден пользователь.
+	UnmatchedEmails []string
+}
+
+// ImportUnavailability синхронизирует периоды недоступности с событиями календаря.
+// Импортируются события, помеченные как отсутствие (статус Outlook OOF или категория
+// Out of office / Vacation): для каждого участника, не отклонившего приглашение (а если
+// участников нет - для организатора), создается или обновляется период, связанный с UID события.
+// Пользователи сопоставляются по email. Периоды отмененных событий, событий без пометки
+// отсутствия и участников, которых больше нет в событии, удаляются, поэтому повторный
+// импорт того же календаря ничего не меняет. Повторения (RRULE) не разворачиваются:
+// импортируется первое вхождение события.
+func (u *UseCase) ImportUnavailability(
+	ctx context.Context,
+	params ImportUnavailabilityParams,
+) (ImportUnavailabilityResult, error) {
+	calendars, err := ical.Parse(params.Calendar)
+	if err != nil {
+		return ImportUnavailabilityResult{}, errors.New(
+			api.ErrInvalidCalendar,
+			errors.WithValidationErrors(map[string]string{"calendar": err.Error()}),
+		)
+	}
+
+	events, invalid := ical.Events(calendars)
+
+	result := ImportUnavailabilityResult{
+		SkippedEvents:   invalid,
+		UnmatchedEmails: make([]string, 0),
+	}
+
+	err = u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		users := make(map[string]data.User)
+
+		for _, event := range events {
+			if event.RecurrenceOverride {
+				result.SkippedEvents++
+
+				continue
+			}
+
+			var keep []uuid.UUID
+
+			if event.OutOfOffice && !event.Cancelled {
+				for _, email := range unavailableAttendees(event) {
+					user, ok, err := u.userByEmailCached(ctx, users, email)
+					if err != nil {
+						return err
+					}
+
+					if !ok {
+						if !slices.Contains(result.UnmatchedEmails, email) {
+							result.UnmatchedEmails = append(result.UnmatchedEmails, email)
+						}
+
+						continue
+					}
+
+					created, err := u.upsertImportedPeriod(ctx, user, event)
+					if err != nil {
+						return err
+					}
+
+					if created {
+						result.Created++
+					} else {
+						result.Updated++
+					}
+
+					keep = append(keep, user.ID)
+				}
+			}
+
+			deleted, err := u.repo.DeleteUnavailabilityPeriodsByUID(ctx, event.UID, keep)
+			if err != nil {
+				log.LoggerFromCtx(ctx).Error("error deleting imported unavailability periods",
+					zap.Error(err),
+					zap.String("UID", event.UID))
+
+				return err
+			}
+
+			result.Deleted += int(deleted)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return ImportUnavailabilityResult{}, err
+	}
+
+	slices.Sort(result.UnmatchedEmails)
+
+	return result, nil
+}
+
+// unavailableAttendees возвращает адреса отсутствующих по событию: участников,
+// не отклонивших приглашение, или организатора, если участников нет.
+func unavailableAttendees(event ical.Event) []string {
+	if len(event.Attendees) == 0 {
+		if event.Organizer == "" {
+			return nil
+		}
+
+		return []string{event.Organizer}
+	}
+
+	emails := make([]string, 0, len(event.Attendees))
+
+	for _, attendee := range event.Attendees {
+		if !attendee.Declined && !slices.Contains(emails, attendee.Email) {
+			emails = append(emails, attendee.Email)
+		}
+	}
+
+	return emails
+}
+
+// userByEmailCached ищет пользователя по email, запоминая результат в cache.
+// ok=false означает, что пользователь не найден.
+func (u *UseCase) userByEmailCached(
+	ctx context.Context,
+	cache map[string]data.User,
+	email string,
+) (user data.User, ok bool, err error) {
+	if user, ok = cache[email]; ok {
+		return user, user.ID != uuid.Nil, nil
+	}
+
+	user, err = u.repo.GetUserByEmail(ctx, email)
+	if errors.Is(err, api.ErrNotFound) {
+		cache[email] = data.User{}
+
+		return data.User{}, false, nil
+	}
+
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting user by email", zap.Error(err))
+
+		return data.User{}, false, err
+	}
+
+	cache[email] = user
+
+	return user, true, nil
+}
+
+// upsertImportedPeriod создает или обновляет период пользователя по событию календаря.
+func (u *UseCase) upsertImportedPeriod(
+	ctx context.Context,
+	user data.User,
+	event ical.Event,
+) (bool, error) {
+	reason := []rune(event.Summary)
+	if len(reason) > maxUnavailabilityReasonLength {
+		reason = reason[:maxUnavailabilityReasonLength]
+	}
+
+	now := time.Now()
+
+	_, created, err := u.repo.UpsertUnavailabilityPeriodByUID(ctx, data.UnavailabilityPeriod{
+		ID:          uuid.New(),
+		UserID:      user.ID,
+		StartsAt:    event.Start,
+		EndsAt:      event.End,
+		Reason:      sql.NullString{String: string(reason), Valid: len(reason) > 0},
+		ExternalUID: sql.NullString{String: event.UID, Valid: true},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error upserting imported unavailability period",
+			zap.Error(err),
+			zap.String("UID", event.UID))
+
+		return false, err
+	}
+
+	return created, nil
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// isKnownLeadRule проверяет, что правило назначения LEAD существует.
+func isKnownLeadRule(rule model.TeamLeadRule) bool {
+	switch rule {
+	case model.TeamLeadRuleNone, model.TeamLeadRuleRequire, model.TeamLeadRuleExclude:
+		return true
+	default:
+		return false
+	}
+}
+
+// teamLeadRule возвращает правило назначения LEAD команды.
+func teamLeadRule(team data.Team) model.TeamLeadRule {
+	if team.LeadRule.Valid && team.LeadRule.V != "" {
+		return team.LeadRule.V
+	}
+
+	return model.TeamLeadRuleNone
+}
+
+func isLeadMember(tm data.TeamMember) bool {
+	return tm.Role == model.TeamMemberRoleLead
+}
+
+func isNotLeadMember(tm data.TeamMember) bool {
+	return tm.Role != model.TeamMemberRoleLead
+}
+
+// isTeamLead проверяет, что пользователь состоит в команде с ролью LEAD.
+func (u *UseCase) isTeamLead(ctx context.Context, teamID, userID uuid.UUID) (bool, error) {
+	teamMember, err := u.repo.GetTeamMemberByTeamAndUser(ctx, teamID, userID)
+	if errors.Is(err, api.ErrNotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team member", zap.Error(err))
+
+		return false, err
+	}
+
+	return isLeadMember(teamMember), nil
+}
+
+// leadMissing проверяет, что команда требует LEAD среди ревьюверов, а среди reviewerIDs его нет.
+func (u *UseCase) leadMissing(
+	ctx context.Context,
+	team data.Team,
+	reviewerIDs []uuid.UUID,
+) (bool, error) {
+	if teamLeadRule(team) != model.TeamLeadRuleRequire {
+		return false, nil
+	}
+
+	for _, reviewerID := range reviewerIDs {
+		isLead, err := u.isTeamLead(ctx, team.ID, reviewerID)
+		if err != nil {
+			return false, err
+		}
+
+		if isLead {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// reviewerIDsWithout возвращает ID текущих ревьюверов, кроме removedID.
+func reviewerIDsWithout(reviewers []data.PRReviewer, removedID uuid.UUID) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(reviewers))
+	for _, r := range reviewers {
+		if r.ReviewerID != removedID {
+			ids = append(ids, r.ReviewerID)
+		}
+	}
+
+	return ids
+}
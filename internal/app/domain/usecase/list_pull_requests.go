@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// ListPullRequestsParams - фильтры списка PR. Пустые значения не ограничивают выборку.
+type ListPullRequestsParams struct {
+	Status            model.PullRequestStatus
+	AuthorID          string
+	ReviewerID        string
+	TeamName          string
+	NeedMoreReviewers *bool
+	CreatedFrom       *time.Time
+	CreatedTo         *time.Time
+	MergedFrom        *time.Time
+	MergedTo          *time.Time
+	// Cursor - NextCursor предыдущей страницы.
+	Cursor string
+	// Limit - размер страницы, по умолчанию defaultPageLimit.
+	Limit int
+}
+
+type ListPullRequestsResult struct {
+	PullRequests []model.PullRequestDetails
+	// NextCursor - курсор следующей страницы (пусто, если страница последняя).
+	NextCursor string
+}
+
+// ListPullRequests возвращает страницу PR по возрастанию времени создания.
+func (u *UseCase) ListPullRequests(
+	ctx context.Context,
+	params ListPullRequestsParams,
+) (ListPullRequestsResult, error) {
+	limit, err := pageLimit(params.Limit)
+	if err != nil {
+		return ListPullRequestsResult{}, err
+	}
+
+	if params.Status != "" && !isKnownPullRequestStatus(params.Status) {
+		return ListPullRequestsResult{}, errors.New(api.ErrUnknownStatus)
+	}
+
+	filter, err := u.pullRequestFilter(ctx, params)
+	if err != nil {
+		return ListPullRequestsResult{}, err
+	}
+
+	// Запрашиваем на один PR больше, чтобы понять, есть ли следующая страница
+	filter.Limit = limit + 1
+
+	prs, err := u.repo.ListPullRequests(ctx, filter)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error listing pull requests", zap.Error(err))
+
+		return ListPullRequestsResult{}, err
+	}
+
+	var result ListPullRequestsResult
+
+	if len(prs) > limit {
+		prs = prs[:limit]
+		last := prs[len(prs)-1]
+		result.NextCursor = encodeCursor(last.CreatedAt, last.ID)
+	}
+
+	teamNames := make(map[uuid.UUID]string)
+	result.PullRequests = make([]model.PullRequestDetails, 0, len(prs))
+
+	for _, pr := range prs {
+		details, err := u.pullRequestDetails(ctx, pr, teamNames)
+		if err != nil {
+			return ListPullRequestsResult{}, err
+		}
+
+		result.PullRequests = append(result.PullRequests, details)
+	}
+
+	return result, nil
+}
+
+// pullRequestFilter переводит параметры списка во внутренний фильтр, разрешая внешние ID.
+func (u *UseCase) pullRequestFilter(
+	ctx context.Context,
+	params ListPullRequestsParams,
+) (data.PullRequestFilter, error) {
+	var filter data.PullRequestFilter
+
+	if params.Status != "" {
+		filter.Status = sql.Null[string]{V: params.Status, Valid: true}
+	}
+
+	if params.AuthorID != "" {
+		author, err := u.repo.GetUserByExternalID(ctx, params.AuthorID)
+		if err != nil {
+			return data.PullRequestFilter{}, err
+		}
+
+		filter.AuthorID = sql.Null[data.UserInternalID]{V: author.ID, Valid: true}
+	}
+
+	if params.ReviewerID != "" {
+		reviewer, err := u.repo.GetUserByExternalID(ctx, params.ReviewerID)
+		if err != nil {
+			return data.PullRequestFilter{}, err
+		}
+
+		filter.ReviewerID = sql.Null[data.UserInternalID]{V: reviewer.ID, Valid: true}
+	}
+
+	if params.TeamName != "" {
+		team, err := u.repo.GetTeamByName(ctx, params.TeamName)
+		if err != nil {
+			return data.PullRequestFilter{}, err
+		}
+
+		filter.TeamID = sql.Null[data.TeamInternalID]{V: team.ID, Valid: true}
+	}
+
+	if params.NeedMoreReviewers != nil {
+		filter.NeedMoreReviewers = sql.Null[bool]{V: *params.NeedMoreReviewers, Valid: true}
+	}
+
+	filter.CreatedFrom = nullTime(params.CreatedFrom)
+	filter.CreatedTo = nullTime(params.CreatedTo)
+	filter.MergedFrom = nullTime(params.MergedFrom)
+	filter.MergedTo = nullTime(params.MergedTo)
+
+	if params.Cursor != "" {
+		createdAt, id, err := decodeCursor(params.Cursor)
+		if err != nil {
+			return data.PullRequestFilter{}, err
+		}
+
+		filter.After = sql.Null[data.PullRequestCursor]{
+			V:     data.PullRequestCursor{CreatedAt: createdAt, ID: id},
+			Valid: true,
+		}
+	}
+
+	return filter, nil
+}
+
+func isKnownPullRequestStatus(status model.PullRequestStatus) bool {
+	switch status {
+	case model.PullRequestStatusOpen,
+		model.PullRequestStatusMerged,
+		model.PullRequestStatusClosed,
+		model.PullRequestStatusDraft:
+		return true
+	default:
+		return false
+	}
+}
+
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type ListReviewerRulesParams struct {
+	// AuthorID - если задан, возвращаются только правила этого автора.
+	AuthorID string
+}
+
+type ListReviewerRulesResult struct {
+	Rules []model.ReviewerRule
+}
+
+func (u *UseCase) ListReviewerRules(
+	ctx context.Context,
+	params ListReviewerRulesParams,
+) (ListReviewerRulesResult, error) {
+	var (
+		rules []data.ReviewerRule
+		err   error
+	)
+
+	if params.AuthorID != "" {
+		author, err := u.repo.GetUserByExternalID(ctx, params.AuthorID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", params.AuthorID))
+
+			return ListReviewerRulesResult{}, err
+		}
+
+		rules, err = u.repo.GetReviewerRulesByAuthorID(ctx, author.ID)
+		if err != nil {
+			return ListReviewerRulesResult{}, err
+		}
+	} else {
+		rules, err = u.repo.GetAllReviewerRules(ctx)
+		if err != nil {
+			return ListReviewerRulesResult{}, err
+		}
+	}
+
+	result := ListReviewerRulesResult{Rules: make([]model.ReviewerRule, 0, len(rules))}
+
+	for _, rule := range rules {
+		modelRule, err := u.toReviewerRule(ctx, rule)
+		if err != nil {
+			return ListReviewerRulesResult{}, err
+		}
+
+		result.Rules = append(result.Rules, modelRule)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type ListUnavailabilityPeriodsParams struct {
+	// UserID - если задан, возвращаются только периоды этого пользователя.
+	UserID string
+	// IncludePast - возвращать и уже закончившиеся периоды.
+	IncludePast bool
+}
+
+type ListUnavailabilityPeriodsResult struct {
+	Periods []model.UnavailabilityPeriod
+}
+
+func (u *UseCase) ListUnavailabilityPeriods(
+	ctx context.Context,
+	params ListUnavailabilityPeriodsParams,
+) (ListUnavailabilityPeriodsResult, error) {
+	var filter data.UnavailabilityFilter
+
+	if params.UserID != "" {
+		user, err := u.repo.GetUserByExternalID(ctx, params.UserID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", params.UserID))
+
+			return ListUnavailabilityPeriodsResult{}, err
+		}
+
+		filter.UserID = sql.Null[uuid.UUID]{V: user.ID, Valid: true}
+	}
+
+	if !params.IncludePast {
+		filter.EndsAfter = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+
+	periods, err := u.repo.ListUnavailabilityPeriods(ctx, filter)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error listing unavailability periods", zap.Error(err))
+
+		return ListUnavailabilityPeriodsResult{}, err
+	}
+
+	result := ListUnavailabilityPeriodsResult{
+		Periods: make([]model.UnavailabilityPeriod, 0, len(periods)),
+	}
+
+	for _, period := range periods {
+		modelPeriod, err := u.toUnavailabilityPeriod(ctx, period)
+		if err != nil {
+			return ListUnavailabilityPeriodsResult{}, err
+		}
+
+		result.Periods = append(result.Periods, modelPeriod)
+	}
+
+	return result, nil
+}
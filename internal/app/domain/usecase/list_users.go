@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// ListUsersParams - фильтры списка пользователей. Пустые значения не ограничивают выборку.
+type ListUsersParams struct {
+	IsActive *bool
+	TeamName string
+	// Cursor - NextCursor предыдущей страницы.
+	Cursor string
+	// Limit - размер страницы, по умолчанию defaultPageLimit.
+	Limit int
+}
+
+type ListUsersResult struct {
+	// Users - пользователи без команд (TeamName не заполняется).
+	Users []model.User
+	// NextCursor - курсор следующей страницы (пусто, если страница последняя).
+	NextCursor string
+}
+
+// ListUsers возвращает страницу пользователей по возрастанию времени создания.
+func (u *UseCase) ListUsers(
+	ctx context.Context,
+	params ListUsersParams,
+) (ListUsersResult, error) {
+	limit, err := pageLimit(params.Limit)
+	if err != nil {
+		return ListUsersResult{}, err
+	}
+
+	// Запрашиваем на одного пользователя больше, чтобы понять, есть ли следующая страница
+	filter := data.UserFilter{Limit: limit + 1}
+
+	if params.IsActive != nil {
+		filter.IsActive = sql.Null[bool]{V: *params.IsActive, Valid: true}
+	}
+
+	if params.TeamName != "" {
+		team, err := u.repo.GetTeamByName(ctx, params.TeamName)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by name",
+				zap.Error(err),
+				zap.String("TeamName", params.TeamName))
+
+			return ListUsersResult{}, err
+		}
+
+		filter.TeamID = sql.Null[data.TeamInternalID]{V: team.ID, Valid: true}
+	}
+
+	if params.Cursor != "" {
+		createdAt, id, err := decodeCursor(params.Cursor)
+		if err != nil {
+			return ListUsersResult{}, err
+		}
+
+		filter.After = sql.Null[data.UserCursor]{
+			V:     data.UserCursor{CreatedAt: createdAt, ID: id},
+			Valid: true,
+		}
+	}
+
+	users, err := u.repo.ListUsers(ctx, filter)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error listing users", zap.Error(err))
+
+		return ListUsersResult{}, err
+	}
+
+	var result ListUsersResult
+
+	if len(users) > limit {
+		users = users[:limit]
+		last := users[len(users)-1]
+		result.NextCursor = encodeCursor(last.CreatedAt, last.ID)
+	}
+
+	result.Users = make([]model.User, 0, len(users))
+	for _, user := range users {
+		result.Users = append(result.Users, userWithPrimaryTeam(user, nil))
+	}
+
+	return result, nil
+}
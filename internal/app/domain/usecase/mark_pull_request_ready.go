@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type MarkPullRequestReadyParams struct {
+	PullRequestID string
+}
+
+type MarkPullRequestReadyResult struct {
+	PR model.PullRequest
+}
+
+// MarkPullRequestReady переводит черновик в OPEN и назначает ревьюверов так же, как при создании
+// PR: назначения записываются в историю с причиной initial (идемпотентная операция для открытого PR).
+func (u *UseCase) MarkPullRequestReady(
+	ctx context.Context,
+	params MarkPullRequestReadyParams,
+) (MarkPullRequestReadyResult, error) {
+	var result MarkPullRequestReadyResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		pr, err := u.repo.GetPullRequestByExternalID(ctx, params.PullRequestID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting pr by external id", zap.Error(err))
+
+			return err
+		}
+
+		switch pr.Status {
+		case model.PullRequestStatusOpen:
+		case model.PullRequestStatusDraft:
+			pr, err = u.setPullRequestStatus(ctx, pr, model.PullRequestStatusOpen)
+			if err != nil {
+				return err
+			}
+
+			pr, err = u.fillReviewers(
+				ctx,
+				pr,
+				sql.Null[data.UserInternalID]{V: pr.AuthorID, Valid: true},
+				model.PRReviewerHistoryChangeReasonInitial,
+			)
+			if err != nil {
+				return err
+			}
+		case model.PullRequestStatusMerged:
+			return errors.New(api.ErrPRMerged)
+		default:
+			return errors.New(api.ErrInvalidStatusTransition)
+		}
+
+		result.PR, err = u.pullRequestModel(ctx, pr)
+
+		return err
+	})
+	if err != nil {
+		return MarkPullRequestReadyResult{}, err
+	}
+
+	return result, nil
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"time"
 
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
 	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
 )
 
 type MergePullRequestParams struct {
@@ -55,6 +57,10 @@ func (u *UseCase) MergePullRequest(
 			return nil
 		}
 
+		if pr.Status != model.PullRequestStatusOpen {
+			return errors.New(api.ErrInvalidStatusTransition)
+		}
+
 		mergedPR, err := u.repo.MergePullRequest(ctx, pr.ID)
 		if err != nil {
 			return fmt.Errorf("failed to merge PR: %w", err)
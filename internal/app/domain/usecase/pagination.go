@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/pkg/errors"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 200
+)
+
+// pageLimit возвращает размер страницы: limit или значение по умолчанию, если limit не задан.
+func pageLimit(limit int) (int, error) {
+	if limit == 0 {
+		return defaultPageLimit, nil
+	}
+
+	if limit < 0 || limit > maxPageLimit {
+		return 0, errors.New(api.ErrInvalidLimit)
+	}
+
+	return limit, nil
+}
+
+// encodeCursor кодирует позицию записи в выборке, упорядоченной по (время, id), в непрозрачную строку.
+func encodeCursor(at time.Time, id uuid.UUID) string {
+	raw := at.UTC().Format(time.RFC3339Nano) + "|" + id.String()
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor разбирает курсор, полученный из encodeCursor.
+func decodeCursor(cursor string) (time.Time, uuid.UUID, error) {
+	at, id, err := parseCursor(cursor)
+	if err != nil {
+		return time.Time{}, uuid.Nil, errors.Wrap(err, api.ErrInvalidCursor)
+	}
+
+	return at, id, nil
+}
+
+func parseCursor(cursor string) (time.Time, uuid.UUID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, uuid.Nil, err
+	}
+
+	atRaw, idRaw, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return time.Time{}, uuid.Nil, fmt.Errorf("malformed cursor")
+	}
+
+	at, err := time.Parse(time.RFC3339Nano, atRaw)
+	if err != nil {
+		return time.Time{}, uuid.Nil, err
+	}
+
+	id, err := uuid.Parse(idRaw)
+	if err != nil {
+		return time.Time{}, uuid.Nil, err
+	}
+
+	return at, id, nil
+}
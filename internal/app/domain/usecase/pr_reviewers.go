@@ -0,0 +1,231 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// addReviewer назначает ревьювера на PR и записывает назначение в историю.
+func (u *UseCase) addReviewer(
+	ctx context.Context,
+	prID, reviewerID, teamID uuid.UUID,
+	changedBy sql.Null[data.UserInternalID],
+	reason model.PRReviewerHistoryChangeReason,
+) error {
+	prReviewer := data.PRReviewer{
+		ID:            uuid.New(),
+		PullRequestID: prID,
+		ReviewerID:    reviewerID,
+		TeamID:        teamID,
+		AssignedAt:    time.Now(),
+		ReplacedAt:    sql.NullTime{},
+		IsCurrent:     true,
+	}
+
+	_, err := u.repo.CreatePRReviewer(ctx, prReviewer)
+	if err != nil {
+		log.LoggerFromCtx(ctx).
+			Error("failed to assign reviewer",
+				zap.Error(err),
+				zap.Any("PRReviewer", prReviewer))
+
+		return fmt.Errorf("failed to assign reviewer: %w", err)
+	}
+
+	return u.logReviewerChange(
+		ctx,
+		prID,
+		sql.Null[data.UserInternalID]{},
+		sql.Null[data.UserInternalID]{V: reviewerID, Valid: true},
+		changedBy,
+		reason,
+	)
+}
+
+// removeReviewer снимает текущее назначение ревьювера с PR без замены и записывает это в историю.
+func (u *UseCase) removeReviewer(
+	ctx context.Context,
+	reviewer data.PRReviewer,
+	changedBy sql.Null[data.UserInternalID],
+	reason model.PRReviewerHistoryChangeReason,
+) error {
+	reviewer.IsCurrent = false
+	reviewer.ReplacedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	_, err := u.repo.UpdatePRReviewer(ctx, reviewer)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error updating pr reviewer",
+			zap.Error(err),
+			zap.Any("Reviewer", reviewer))
+
+		return fmt.Errorf("failed to remove reviewer: %w", err)
+	}
+
+	return u.logReviewerChange(
+		ctx,
+		reviewer.PullRequestID,
+		sql.Null[data.UserInternalID]{V: reviewer.ReviewerID, Valid: true},
+		sql.Null[data.UserInternalID]{},
+		changedBy,
+		reason,
+	)
+}
+
+// logReviewerChange записывает изменение состава ревьюверов PR в историю.
+// Изменение приписывается инициатору запроса, а если он неизвестен - changedBy.
+func (u *UseCase) logReviewerChange(
+	ctx context.Context,
+	prID uuid.UUID,
+	oldReviewerID, newReviewerID, changedBy sql.Null[data.UserInternalID],
+	reason model.PRReviewerHistoryChangeReason,
+) error {
+	actorID, comment, err := u.requestActor(ctx)
+	if err != nil {
+		return err
+	}
+
+	if actorID.Valid {
+		changedBy = actorID
+	}
+
+	history := data.PRReviewerHistory{
+		ID:            uuid.New(),
+		PullRequestID: prID,
+		OldReviewerID: oldReviewerID,
+		NewReviewerID: newReviewerID,
+		ChangedBy:     changedBy,
+		ChangedAt:     time.Now(),
+		Reason:        reason,
+		Comment:       comment,
+	}
+
+	_, err = u.repo.CreatePRReviewerHistory(ctx, history)
+	if err != nil {
+		log.LoggerFromCtx(ctx).
+			Error("failed to log reviewer change",
+				zap.Error(err),
+				zap.Any("History", history))
+
+		return fmt.Errorf("failed to log reviewer change: %w", err)
+	}
+
+	return nil
+}
+
+// validateExplicitReviewer проверяет, что пользователя можно явно назначить ревьювером PR от
+// имени команды team: PR открыт, пользователь активен, не является автором, еще не назначен,
+// не исключен правилом never_review автора, доступен и не достиг лимита открытых ревью.
+func (u *UseCase) validateExplicitReviewer(
+	ctx context.Context,
+	pr data.PullRequest,
+	reviewers []data.PRReviewer,
+	user data.User,
+	team data.Team,
+) error {
+	if err := ensurePullRequestOpen(pr); err != nil {
+		return err
+	}
+
+	if !user.IsActive {
+		return errors.New(api.ErrReviewerInactive)
+	}
+
+	if pr.AuthorID == user.ID {
+		return errors.New(api.ErrReviewerIsAuthor)
+	}
+
+	if _, ok := currentReviewer(reviewers, user.ID); ok {
+		return errors.New(api.ErrAlreadyAssigned)
+	}
+
+	err := u.ensureReviewerAllowed(ctx, pr.AuthorID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	unavailable, err := u.isUnavailable(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if unavailable {
+		return errors.New(api.ErrReviewerUnavailable)
+	}
+
+	openReviews, err := u.reviewerOpenReviews(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if atCapacity(u.reviewerCapacity(user, team), openReviews) {
+		return errors.New(api.ErrReviewerAtCapacity)
+	}
+
+	return nil
+}
+
+// currentReviewer возвращает текущее назначение пользователя на PR.
+func currentReviewer(reviewers []data.PRReviewer, userID uuid.UUID) (data.PRReviewer, bool) {
+	for _, r := range reviewers {
+		if r.ReviewerID == userID {
+			return r, true
+		}
+	}
+
+	return data.PRReviewer{}, false
+}
+
+// pullRequestModel возвращает PR с внешними ID автора и текущих ревьюверов.
+func (u *UseCase) pullRequestModel(
+	ctx context.Context,
+	pr data.PullRequest,
+) (model.PullRequest, error) {
+	author, err := u.repo.GetUserByID(ctx, pr.AuthorID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting author", zap.Error(err))
+
+		return model.PullRequest{}, fmt.Errorf("failed to get author: %w", err)
+	}
+
+	reviewers, err := u.repo.GetCurrentReviewers(ctx, pr.ID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting current reviewers", zap.Error(err))
+
+		return model.PullRequest{}, fmt.Errorf("failed to get reviewers: %w", err)
+	}
+
+	reviewerIDs := make([]string, 0, len(reviewers))
+
+	for _, r := range reviewers {
+		user, err := u.repo.GetUserByID(ctx, r.ReviewerID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by id", zap.Error(err))
+
+			return model.PullRequest{}, fmt.Errorf("failed to get reviewer: %w", err)
+		}
+
+		reviewerIDs = append(reviewerIDs, user.ExternalID)
+	}
+
+	return model.PullRequest{
+		PullRequestShort: model.PullRequestShort{
+			PullRequestID:   pr.ExternalID,
+			PullRequestName: pr.Title,
+			AuthorID:        author.ExternalID,
+			Status:          pr.Status,
+		},
+		AssignedReviewers: reviewerIDs,
+		NeedMoreReviewers: pr.NeedMoreReviewers,
+	}, nil
+}
@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// ensurePullRequestOpen проверяет, что состав ревьюверов PR можно менять.
+func ensurePullRequestOpen(pr data.PullRequest) error {
+	switch pr.Status {
+	case model.PullRequestStatusOpen:
+		return nil
+	case model.PullRequestStatusMerged:
+		return errors.New(api.ErrPRMerged)
+	default:
+		return errors.New(api.ErrPRNotOpen)
+	}
+}
+
+// setPullRequestStatus переводит PR в статус status.
+func (u *UseCase) setPullRequestStatus(
+	ctx context.Context,
+	pr data.PullRequest,
+	status model.PullRequestStatus,
+) (data.PullRequest, error) {
+	pr.Status = status
+
+	updatedPR, err := u.repo.UpdatePullRequest(ctx, pr)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error updating pr status",
+			zap.Error(err),
+			zap.String("Status", status))
+
+		return data.PullRequest{}, err
+	}
+
+	return updatedPR, nil
+}
+
+// fillReviewers снимает с открытого PR устаревших ревьюверов (см. dropStaleReviewers),
+// доназначает недостающих стратегией команды PR и обновляет флаг need_more_reviewers.
+// Назначения записываются в историю с причиной reason от имени changedBy.
+func (u *UseCase) fillReviewers(
+	ctx context.Context,
+	pr data.PullRequest,
+	changedBy sql.Null[data.UserInternalID],
+	reason model.PRReviewerHistoryChangeReason,
+) (data.PullRequest, error) {
+	remaining, err := u.dropStaleReviewers(ctx, pr)
+	if err != nil {
+		return data.PullRequest{}, err
+	}
+
+	if !pr.TeamID.Valid {
+		return pr, nil
+	}
+
+	team, err := u.repo.GetTeamByID(ctx, pr.TeamID.V)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team by id", zap.Error(err))
+
+		return data.PullRequest{}, err
+	}
+
+	leadMissing, err := u.leadMissing(ctx, team, remaining)
+	if err != nil {
+		return data.PullRequest{}, err
+	}
+
+	requiredReviewers := u.teamRequiredReviewers(team)
+
+	selected, err := u.selectReviewersWithFallbacks(
+		ctx,
+		team,
+		pr.AuthorID,
+		append([]uuid.UUID{pr.AuthorID}, remaining...),
+		max(0, requiredReviewers-len(remaining)),
+		leadMissing,
+	)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error selecting reviewers", zap.Error(err))
+
+		return data.PullRequest{}, err
+	}
+
+	for _, reviewer := range selected {
+		err = u.addReviewer(
+			ctx,
+			pr.ID,
+			reviewer.User.ID,
+			reviewer.TeamID,
+			changedBy,
+			reason,
+		)
+		if err != nil {
+			return data.PullRequest{}, err
+		}
+	}
+
+	return u.refreshNeedMoreReviewers(ctx, pr)
+}
+
+// dropStaleReviewers снимает с PR ревьюверов, которые были деактивированы или покинули
+// команду, пока PR не был открыт (передача ревью обрабатывает только открытые PR).
+// Возвращает ID оставшихся ревьюверов.
+func (u *UseCase) dropStaleReviewers(ctx context.Context, pr data.PullRequest) ([]uuid.UUID, error) {
+	reviewers, err := u.repo.GetCurrentReviewers(ctx, pr.ID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting current reviewers", zap.Error(err))
+
+		return nil, err
+	}
+
+	remaining := make([]uuid.UUID, 0, len(reviewers))
+
+	for _, reviewer := range reviewers {
+		user, err := u.repo.GetUserByID(ctx, reviewer.ReviewerID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by id", zap.Error(err))
+
+			return nil, err
+		}
+
+		reason := ""
+
+		if !user.IsActive {
+			reason = model.PRReviewerHistoryChangeReasonDeactivation
+		} else if reviewer.TeamID != uuid.Nil {
+			_, err = u.repo.GetTeamMemberByTeamAndUser(ctx, reviewer.TeamID, reviewer.ReviewerID)
+			if errors.Is(err, api.ErrNotFound) {
+				reason = model.PRReviewerHistoryChangeReasonTeamLeave
+			} else if err != nil {
+				log.LoggerFromCtx(ctx).Error("error getting team member", zap.Error(err))
+
+				return nil, err
+			}
+		}
+
+		if reason == "" {
+			remaining = append(remaining, reviewer.ReviewerID)
+
+			continue
+		}
+
+		err = u.removeReviewer(ctx, reviewer, sql.Null[data.UserInternalID]{}, reason)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return remaining, nil
+}
+
+// hadReviewers проверяет, назначались ли на PR ревьюверы: у черновика, закрытого до перевода
+// в OPEN, истории назначений нет.
+func (u *UseCase) hadReviewers(ctx context.Context, prID uuid.UUID) (bool, error) {
+	history, err := u.repo.GetPRReviewerHistory(ctx, prID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting pr reviewer history", zap.Error(err))
+
+		return false, err
+	}
+
+	for _, h := range history {
+		if h.NewReviewerID.Valid {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
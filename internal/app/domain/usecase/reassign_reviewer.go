@@ -4,7 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"math/rand"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,6 +19,8 @@ import (
 type ReassignReviewerParams struct {
 	PullRequestID string
 	OldReviewerID string
+	// NewReviewerID - внешний ID выбранной замены. Если не задан, замена подбирается автоматически.
+	NewReviewerID string
 }
 
 type ReassignReviewerResult struct {
@@ -39,8 +40,9 @@ func (u *UseCase) ReassignReviewer(
 			return fmt.Errorf("PR not found")
 		}
 
-		if pr.Status == model.PullRequestStatusMerged {
-			return fmt.Errorf("PR is merged")
+		err = ensurePullRequestOpen(pr)
+		if err != nil {
+			return err
 		}
 
 		oldReviewer, err := u.repo.GetUserByExternalID(ctx, params.OldReviewerID)
@@ -67,31 +69,64 @@ func (u *UseCase) ReassignReviewer(
 			return fmt.Errorf("reviewer not assigned")
 		}
 
-		newReviewer, err := u.findReplacementReviewer(ctx, reviewerTeamID, oldReviewer.ID)
-		if err != nil {
-			return fmt.Errorf("no candidate available")
+		exclude := []uuid.UUID{pr.AuthorID}
+		for _, r := range reviewers {
+			exclude = append(exclude, r.ReviewerID)
 		}
 
-		err = u.replaceReviewer(ctx, pr.ID, oldReviewer.ID, newReviewer.ID, reviewerTeamID)
+		remaining := reviewerIDsWithout(reviewers, oldReviewer.ID)
+
+		var (
+			newReviewer       data.User
+			newReviewerTeamID uuid.UUID
+		)
+
+		if params.NewReviewerID != "" {
+			newReviewer, err = u.explicitReplacementReviewer(
+				ctx,
+				pr,
+				reviewers,
+				reviewerTeamID,
+				params.NewReviewerID,
+			)
+			if err != nil {
+				return err
+			}
+
+			newReviewerTeamID = reviewerTeamID
+		} else {
+			newReviewer, newReviewerTeamID, err = u.findReplacementReviewer(
+				ctx,
+				reviewerTeamID,
+				pr.AuthorID,
+				exclude,
+				remaining,
+			)
+			if err != nil {
+				return fmt.Errorf("no candidate available")
+			}
+		}
+
+		err = u.replaceReviewer(ctx, pr.ID, oldReviewer.ID, newReviewer.ID, newReviewerTeamID)
 		if err != nil {
 			return fmt.Errorf("failed to replace reviewer: %w", err)
 		}
 
-		history := data.PRReviewerHistory{
-			ID:            uuid.New(),
-			PullRequestID: pr.ID,
-			OldReviewerID: sql.Null[data.UserInternalID]{V: oldReviewer.ID, Valid: true},
-			NewReviewerID: newReviewer.ID,
-			ChangedBy:     sql.Null[data.UserInternalID]{}, //nolint:exhaustruct // Системное изменение
-			ChangedAt:     time.Now(),
-			Reason:        model.PRReviewerHistoryChangeReasonReassignment,
+		err = u.logReviewerChange(
+			ctx,
+			pr.ID,
+			sql.Null[data.UserInternalID]{V: oldReviewer.ID, Valid: true},
+			sql.Null[data.UserInternalID]{V: newReviewer.ID, Valid: true},
+			sql.Null[data.UserInternalID]{},
+			model.PRReviewerHistoryChangeReasonReassignment,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to log reassignment: %w", err)
 		}
 
-		_, err = u.repo.CreatePRReviewerHistory(ctx, history)
+		err = u.markIfLeadMissing(ctx, pr, reviewerTeamID, append(remaining, newReviewer.ID))
 		if err != nil {
-			log.LoggerFromCtx(ctx).Error("error creating review history", zap.Error(err))
-
-			return fmt.Errorf("failed to log reassignment: %w", err)
+			return err
 		}
 
 		updatedReviewers, err := u.repo.GetCurrentReviewers(ctx, pr.ID)
@@ -141,43 +176,87 @@ func (u *UseCase) ReassignReviewer(
 	return result, nil
 }
 
+// findReplacementReviewer выбирает замену стратегией команды с учетом правил автора PR,
+// пропуская автора и текущих ревьюверов этого PR, а если в команде никого не осталось -
+// из ее резервных команд.
+// remaining - ревьюверы, остающиеся на PR после замены: если среди них нет LEAD,
+// а команда его требует, в замену выбирается LEAD.
+// Возвращает ревьювера и команду, из которой он выбран.
 func (u *UseCase) findReplacementReviewer(
 	ctx context.Context,
-	teamID, excludeUserID uuid.UUID,
-) (data.User, error) {
-	teamMembers, err := u.repo.GetTeamMembersByTeamID(ctx, teamID)
+	teamID uuid.UUID,
+	authorID uuid.UUID,
+	exclude []uuid.UUID,
+	remaining []uuid.UUID,
+) (data.User, uuid.UUID, error) {
+	team, err := u.repo.GetTeamByID(ctx, teamID)
 	if err != nil {
-		log.LoggerFromCtx(ctx).Error("error getting team members by team id",
+		log.LoggerFromCtx(ctx).Error("error getting team by id",
 			zap.Error(err),
 			zap.String("TeamID", teamID.String()))
 
-		return data.User{}, err
+		return data.User{}, uuid.Nil, err
 	}
 
-	var availableUsers []data.User
+	needLead, err := u.leadMissing(ctx, team, remaining)
+	if err != nil {
+		return data.User{}, uuid.Nil, err
+	}
 
-	for _, tm := range teamMembers {
-		user, err := u.repo.GetUserByID(ctx, tm.UserID)
-		if err != nil {
-			log.LoggerFromCtx(ctx).Error("error getting user by id",
-				zap.Error(err),
-				zap.String("UserID", tm.UserID.String()))
+	selected, err := u.selectReviewersWithFallbacks(ctx, team, authorID, exclude, 1, needLead)
+	if err != nil {
+		return data.User{}, uuid.Nil, err
+	}
 
-			continue
-		}
+	if len(selected) == 0 {
+		return data.User{}, uuid.Nil, errors.New(api.ErrNoCandidate)
+	}
 
-		if user.IsActive && user.ID != excludeUserID {
-			availableUsers = append(availableUsers, user)
-		}
+	return selected[0].User, selected[0].TeamID, nil
+}
+
+// explicitReplacementReviewer проверяет, что выбранную замену можно назначить на PR:
+// она должна состоять в команде заменяемого ревьювера и пройти validateExplicitReviewer.
+func (u *UseCase) explicitReplacementReviewer(
+	ctx context.Context,
+	pr data.PullRequest,
+	reviewers []data.PRReviewer,
+	teamID uuid.UUID,
+	externalID string,
+) (data.User, error) {
+	newReviewer, err := u.repo.GetUserByExternalID(ctx, externalID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting user by external id",
+			zap.Error(err),
+			zap.String("UserID", externalID))
+
+		return data.User{}, err
 	}
 
-	if len(availableUsers) == 0 {
-		return data.User{}, errors.New(api.ErrNoCandidate)
+	_, err = u.repo.GetTeamMemberByTeamAndUser(ctx, teamID, newReviewer.ID)
+	if errors.Is(err, api.ErrNotFound) {
+		return data.User{}, errors.New(api.ErrNotTeamMember)
 	}
 
-	selected := availableUsers[rand.Intn(len(availableUsers))] //nolint:gosec // Не принципиально
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team member", zap.Error(err))
 
-	return selected, nil
+		return data.User{}, err
+	}
+
+	team, err := u.repo.GetTeamByID(ctx, teamID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team by id", zap.Error(err))
+
+		return data.User{}, err
+	}
+
+	err = u.validateExplicitReviewer(ctx, pr, reviewers, newReviewer, team)
+	if err != nil {
+		return data.User{}, err
+	}
+
+	return newReviewer, nil
 }
 
 func (u *UseCase) replaceReviewer(
@@ -230,3 +309,39 @@ func (u *UseCase) replaceReviewer(
 
 	return nil
 }
+
+// markIfLeadMissing помечает PR флагом need_more_reviewers, если команда требует LEAD,
+// а среди reviewerIDs его нет.
+func (u *UseCase) markIfLeadMissing(
+	ctx context.Context,
+	pr data.PullRequest,
+	teamID uuid.UUID,
+	reviewerIDs []uuid.UUID,
+) error {
+	if pr.NeedMoreReviewers {
+		return nil
+	}
+
+	team, err := u.repo.GetTeamByID(ctx, teamID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team by id", zap.Error(err))
+
+		return err
+	}
+
+	missing, err := u.leadMissing(ctx, team, reviewerIDs)
+	if err != nil || !missing {
+		return err
+	}
+
+	pr.NeedMoreReviewers = true
+
+	_, err = u.repo.UpdatePullRequest(ctx, pr)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error updating pr", zap.Error(err))
+
+		return fmt.Errorf("failed to update PR: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type RemovePullRequestReviewerParams struct {
+	PullRequestID string
+	ReviewerID    string
+	// ChangedBy - внешний ID пользователя, выполняющего изменение, если инициатор запроса неизвестен.
+	ChangedBy string
+}
+
+type RemovePullRequestReviewerResult struct {
+	PR model.PullRequest
+}
+
+// RemovePullRequestReviewer снимает ревьювера с PR без замены. Если ревьюверов
+// становится меньше необходимого, PR помечается флагом need_more_reviewers.
+func (u *UseCase) RemovePullRequestReviewer(
+	ctx context.Context,
+	params RemovePullRequestReviewerParams,
+) (RemovePullRequestReviewerResult, error) {
+	var result RemovePullRequestReviewerResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		pr, err := u.repo.GetPullRequestByExternalID(ctx, params.PullRequestID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting pr by external id", zap.Error(err))
+
+			return err
+		}
+
+		err = ensurePullRequestOpen(pr)
+		if err != nil {
+			return err
+		}
+
+		reviewer, err := u.repo.GetUserByExternalID(ctx, params.ReviewerID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", params.ReviewerID))
+
+			return err
+		}
+
+		changedBy, err := u.actorID(ctx, params.ChangedBy)
+		if err != nil {
+			return err
+		}
+
+		reviewers, err := u.repo.GetCurrentReviewers(ctx, pr.ID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting current reviewers", zap.Error(err))
+
+			return err
+		}
+
+		assignment, ok := currentReviewer(reviewers, reviewer.ID)
+		if !ok {
+			return errors.New(api.ErrNotAssigned)
+		}
+
+		err = u.removeReviewer(
+			ctx,
+			assignment,
+			changedBy,
+			model.PRReviewerHistoryChangeReasonManual,
+		)
+		if err != nil {
+			return err
+		}
+
+		pr, err = u.refreshNeedMoreReviewers(ctx, pr)
+		if err != nil {
+			return err
+		}
+
+		result.PR, err = u.pullRequestModel(ctx, pr)
+
+		return err
+	})
+	if err != nil {
+		return RemovePullRequestReviewerResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type RemoveTeamMemberParams struct {
+	TeamName string
+	UserID   string
+}
+
+type RemoveTeamMemberResult struct {
+	Team model.Team
+	// HandOff - открытые ревью, на которые участник был назначен из этой команды.
+	HandOff ReviewHandOff
+}
+
+// RemoveTeamMember исключает участника из команды с передачей его открытых ревью (см. RemoveUserTeam).
+func (u *UseCase) RemoveTeamMember(
+	ctx context.Context,
+	params RemoveTeamMemberParams,
+) (RemoveTeamMemberResult, error) {
+	var result RemoveTeamMemberResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		removed, err := u.RemoveUserTeam(ctx, RemoveUserTeamParams{
+			UserID:   params.UserID,
+			TeamName: params.TeamName,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.HandOff = removed.HandOff
+
+		team, err := u.repo.GetTeamByName(ctx, params.TeamName)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by name",
+				zap.Error(err),
+				zap.String("TeamName", params.TeamName))
+
+			return err
+		}
+
+		members, err := u.teamMembers(ctx, team.ID)
+		if err != nil {
+			return err
+		}
+
+		result.Team, err = u.teamModel(ctx, team, members)
+
+		return err
+	})
+	if err != nil {
+		return RemoveTeamMemberResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type RemoveUserTeamParams struct {
+	UserID   string
+	TeamName string
+}
+
+type RemoveUserTeamResult struct {
+	User  model.User
+	Teams []model.UserTeam
+	// HandOff - ревью, на которые пользователь был назначен из этой команды.
+	HandOff ReviewHandOff
+}
+
+// RemoveUserTeam исключает пользователя из команды. Открытые ревью, на которые он был назначен
+// из этой команды, передаются другим участникам. Если команда была основной, основной
+// становится следующая из оставшихся.
+func (u *UseCase) RemoveUserTeam(
+	ctx context.Context,
+	params RemoveUserTeamParams,
+) (RemoveUserTeamResult, error) {
+	var result RemoveUserTeamResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		user, err := u.repo.GetUserByExternalID(ctx, params.UserID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", params.UserID))
+
+			return err
+		}
+
+		team, err := u.repo.GetTeamByName(ctx, params.TeamName)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by name",
+				zap.Error(err),
+				zap.String("TeamName", params.TeamName))
+
+			return err
+		}
+
+		teamMember, err := u.repo.GetTeamMemberByTeamAndUser(ctx, team.ID, user.ID)
+		if errors.Is(err, api.ErrNotFound) {
+			return errors.New(api.ErrNotTeamMember)
+		}
+
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team member", zap.Error(err))
+
+			return err
+		}
+
+		result.HandOff, err = u.leaveTeam(ctx, user, teamMember)
+		if err != nil {
+			return err
+		}
+
+		result.Teams, err = u.userTeams(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		result.User = userWithPrimaryTeam(user, result.Teams)
+
+		return nil
+	})
+	if err != nil {
+		return RemoveUserTeamResult{}, err
+	}
+
+	return result, nil
+}
+
+// leaveTeam исключает пользователя из команды, передавая открытые ревью, на которые он был
+// назначен из этой команды. Если команда была основной, основной становится следующая.
+func (u *UseCase) leaveTeam(
+	ctx context.Context,
+	user data.User,
+	teamMember data.TeamMember,
+) (ReviewHandOff, error) {
+	return u.leaveTeamTogether(ctx, []data.User{user}, []data.TeamMember{teamMember})
+}
+
+// leaveTeamTogether исключает из команды сразу нескольких пользователей (teamMembers[i] - участие
+// users[i]). Открытые ревью передаются только после удаления всех участий, чтобы они не достались
+// тем, кто исключается в этом же запросе.
+func (u *UseCase) leaveTeamTogether(
+	ctx context.Context,
+	users []data.User,
+	teamMembers []data.TeamMember,
+) (ReviewHandOff, error) {
+	for i, teamMember := range teamMembers {
+		err := u.deleteTeamMembership(ctx, users[i], teamMember)
+		if err != nil {
+			return ReviewHandOff{}, err
+		}
+	}
+
+	var handOff ReviewHandOff
+
+	for i, teamMember := range teamMembers {
+		userHandOff, err := u.handOffTeamReviews(
+			ctx,
+			users[i],
+			teamMember.TeamID,
+			model.PRReviewerHistoryChangeReasonTeamLeave,
+		)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error handing off reviews", zap.Error(err))
+
+			return ReviewHandOff{}, fmt.Errorf("failed to hand off reviews: %w", err)
+		}
+
+		handOff.merge(userHandOff)
+	}
+
+	return handOff, nil
+}
+
+// deleteTeamMembership удаляет участие пользователя в команде. Если команда была основной,
+// основной становится следующая из оставшихся.
+func (u *UseCase) deleteTeamMembership(
+	ctx context.Context,
+	user data.User,
+	teamMember data.TeamMember,
+) error {
+	err := u.repo.DeleteTeamMember(ctx, teamMember.ID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error deleting team member", zap.Error(err))
+
+		return err
+	}
+
+	if !teamMember.IsPrimary {
+		return nil
+	}
+
+	teamMembers, err := u.repo.GetTeamMembersByUserID(ctx, user.ID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team members", zap.Error(err))
+
+		return err
+	}
+
+	if len(teamMembers) > 0 {
+		err = u.repo.SetPrimaryTeamMember(ctx, user.ID, teamMembers[0].ID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error setting primary team", zap.Error(err))
+
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type ReopenPullRequestParams struct {
+	PullRequestID string
+}
+
+type ReopenPullRequestResult struct {
+	PR model.PullRequest
+}
+
+// ReopenPullRequest переоткрывает закрытый PR (идемпотентная операция для открытого PR).
+// Ревьюверы, деактивированные или покинувшие команду за время закрытия, заменяются; если ревьюверов
+// на PR еще не назначали (закрыт черновик), назначения записываются в историю с причиной initial.
+func (u *UseCase) ReopenPullRequest(
+	ctx context.Context,
+	params ReopenPullRequestParams,
+) (ReopenPullRequestResult, error) {
+	var result ReopenPullRequestResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		pr, err := u.repo.GetPullRequestByExternalID(ctx, params.PullRequestID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting pr by external id", zap.Error(err))
+
+			return err
+		}
+
+		switch pr.Status {
+		case model.PullRequestStatusOpen:
+		case model.PullRequestStatusClosed:
+			pr, err = u.setPullRequestStatus(ctx, pr, model.PullRequestStatusOpen)
+			if err != nil {
+				return err
+			}
+
+			hadReviewers, err := u.hadReviewers(ctx, pr.ID)
+			if err != nil {
+				return err
+			}
+
+			changedBy := sql.Null[data.UserInternalID]{}
+			reason := model.PRReviewerHistoryChangeReasonTopUp
+
+			if !hadReviewers {
+				changedBy = sql.Null[data.UserInternalID]{V: pr.AuthorID, Valid: true}
+				reason = model.PRReviewerHistoryChangeReasonInitial
+			}
+
+			pr, err = u.fillReviewers(ctx, pr, changedBy, reason)
+			if err != nil {
+				return err
+			}
+		case model.PullRequestStatusMerged:
+			return errors.New(api.ErrPRMerged)
+		default:
+			return errors.New(api.ErrInvalidStatusTransition)
+		}
+
+		result.PR, err = u.pullRequestModel(ctx, pr)
+
+		return err
+	})
+	if err != nil {
+		return ReopenPullRequestResult{}, err
+	}
+
+	return result, nil
+}
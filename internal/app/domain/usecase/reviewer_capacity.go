@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// teamMaxOpenReviews возвращает лимит назначений на открытые PR для участников команды
+// (0 - без ограничений).
+func (u *UseCase) teamMaxOpenReviews(team data.Team) int {
+	if team.MaxOpenReviews.Valid {
+		return team.MaxOpenReviews.V
+	}
+
+	return u.defaultMaxOpenReviews
+}
+
+// reviewerCapacity возвращает лимит назначений пользователя на открытые PR при назначении
+// из команды team: собственный лимит пользователя, иначе лимит команды, иначе значение
+// из конфигурации (0 - без ограничений).
+func (u *UseCase) reviewerCapacity(user data.User, team data.Team) int {
+	if user.MaxOpenReviews.Valid {
+		return user.MaxOpenReviews.V
+	}
+
+	return u.teamMaxOpenReviews(team)
+}
+
+// atCapacity проверяет, что пользователь с openReviews назначениями исчерпал лимит capacity.
+func atCapacity(capacity int, openReviews int64) bool {
+	return capacity > 0 && openReviews >= int64(capacity)
+}
+
+// reviewerOpenReviews возвращает количество текущих назначений пользователя на открытые PR.
+func (u *UseCase) reviewerOpenReviews(ctx context.Context, userID uuid.UUID) (int64, error) {
+	loads, err := u.repo.GetReviewersLoad(ctx, []uuid.UUID{userID})
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting reviewers load",
+			zap.Error(err),
+			zap.String("UserID", userID.String()))
+
+		return 0, err
+	}
+
+	for _, load := range loads {
+		if load.ReviewerID == userID {
+			return load.OpenReviews, nil
+		}
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// isKnownRuleKind проверяет, что вид правила подбора ревьюверов существует.
+func isKnownRuleKind(kind model.ReviewerRuleKind) bool {
+	return kind == model.ReviewerRuleKindNeverReview || kind == model.ReviewerRuleKindPrefer
+}
+
+// authorReviewerRules возвращает пользователей, которых нельзя назначать на PR автора (never),
+// и пользователей, которых нужно назначать в первую очередь (prefer).
+func (u *UseCase) authorReviewerRules(
+	ctx context.Context,
+	authorID uuid.UUID,
+) (never []uuid.UUID, prefer []uuid.UUID, err error) {
+	rules, err := u.repo.GetReviewerRulesByAuthorID(ctx, authorID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting reviewer rules",
+			zap.Error(err),
+			zap.String("AuthorID", authorID.String()))
+
+		return nil, nil, err
+	}
+
+	for _, rule := range rules {
+		switch rule.Kind {
+		case model.ReviewerRuleKindNeverReview:
+			never = append(never, rule.ReviewerID)
+		case model.ReviewerRuleKindPrefer:
+			prefer = append(prefer, rule.ReviewerID)
+		}
+	}
+
+	return never, prefer, nil
+}
+
+// ensureReviewerAllowed проверяет, что у автора PR нет правила never_review для ревьювера.
+func (u *UseCase) ensureReviewerAllowed(ctx context.Context, authorID, reviewerID uuid.UUID) error {
+	never, _, err := u.authorReviewerRules(ctx, authorID)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(never, reviewerID) {
+		return errors.New(api.ErrReviewerForbidden)
+	}
+
+	return nil
+}
+
+// toReviewerRule переводит правило в доменную модель с внешними ID пользователей.
+func (u *UseCase) toReviewerRule(
+	ctx context.Context,
+	rule data.ReviewerRule,
+) (model.ReviewerRule, error) {
+	author, err := u.repo.GetUserByID(ctx, rule.AuthorID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting user by id", zap.Error(err))
+
+		return model.ReviewerRule{}, err
+	}
+
+	reviewer, err := u.repo.GetUserByID(ctx, rule.ReviewerID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting user by id", zap.Error(err))
+
+		return model.ReviewerRule{}, err
+	}
+
+	return model.ReviewerRule{
+		Kind:       rule.Kind,
+		AuthorID:   author.ExternalID,
+		ReviewerID: reviewer.ExternalID,
+	}, nil
+}
@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/internal/app/domain/selector"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// selectReviewers подбирает до count активных ревьюверов из команды стратегией команды,
+// пропуская пользователей из exclude и соблюдая правило назначения LEAD команды.
+// Пользователи из prefer выбираются раньше остальных.
+// needLead означает, что среди уже назначенных ревьюверов нет LEAD: если команда
+// требует LEAD, первым выбирается LEAD (если есть доступный).
+func (u *UseCase) selectReviewers(
+	ctx context.Context,
+	team data.Team,
+	exclude []uuid.UUID,
+	prefer []uuid.UUID,
+	count int,
+	needLead bool,
+) ([]data.User, error) {
+	if count <= 0 {
+		return make([]data.User, 0), nil
+	}
+
+	selected := make([]data.User, 0, count)
+	leadRule := teamLeadRule(team)
+
+	if leadRule == model.TeamLeadRuleRequire && needLead {
+		leads, err := u.pickReviewers(ctx, team, exclude, prefer, 1, isLeadMember)
+		if err != nil {
+			return nil, err
+		}
+
+		selected = append(selected, leads...)
+
+		exclude = slices.Clone(exclude)
+		for _, lead := range leads {
+			exclude = append(exclude, lead.ID)
+		}
+	}
+
+	filter := func(data.TeamMember) bool { return true }
+	if leadRule == model.TeamLeadRuleExclude {
+		filter = isNotLeadMember
+	}
+
+	rest, err := u.pickReviewers(ctx, team, exclude, prefer, count-len(selected), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(selected, rest...), nil
+}
+
+// pickReviewers выбирает стратегией команды до count кандидатов среди участников,
+// удовлетворяющих filter: сначала среди пользователей из prefer, затем среди остальных.
+func (u *UseCase) pickReviewers(
+	ctx context.Context,
+	team data.Team,
+	exclude []uuid.UUID,
+	prefer []uuid.UUID,
+	count int,
+	filter func(data.TeamMember) bool,
+) ([]data.User, error) {
+	if count <= 0 {
+		return make([]data.User, 0), nil
+	}
+
+	candidates, err := u.collectCandidates(ctx, team, exclude, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(candidates) == 0 {
+		return make([]data.User, 0), nil
+	}
+
+	opts := u.selectorOptions
+	opts.PreferWorkingHours = u.teamPreferWorkingHours(team)
+
+	reviewerSelector, err := selector.New(u.teamStrategy(team), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var preferred, others []selector.Candidate
+
+	for _, candidate := range candidates {
+		if slices.Contains(prefer, candidate.User.ID) {
+			preferred = append(preferred, candidate)
+		} else {
+			others = append(others, candidate)
+		}
+	}
+
+	selected := reviewerSelector.Select(preferred, count)
+	selected = append(selected, reviewerSelector.Select(others, count-len(selected))...)
+
+	users := make([]data.User, 0, len(selected))
+	for _, candidate := range selected {
+		users = append(users, candidate.User)
+	}
+
+	return users, nil
+}
+
+// teamStrategy возвращает стратегию выбора команды или стратегию по умолчанию.
+func (u *UseCase) teamStrategy(team data.Team) selector.Strategy {
+	if team.ReviewerStrategy.Valid && team.ReviewerStrategy.V != "" {
+		return team.ReviewerStrategy.V
+	}
+
+	return u.defaultStrategy
+}
+
+// teamPreferWorkingHours проверяет, что команда выбирает сначала ревьюверов в рабочих часах
+// (собственная настройка команды, иначе значение из конфигурации).
+func (u *UseCase) teamPreferWorkingHours(team data.Team) bool {
+	if team.PreferWorkingHours.Valid {
+		return team.PreferWorkingHours.V
+	}
+
+	return u.defaultPreferWorkingHours
+}
+
+// teamRequiredReviewers возвращает количество ревьюверов, которое нужно назначать на PR команды.
+func (u *UseCase) teamRequiredReviewers(team data.Team) int {
+	if team.RequiredReviewers.Valid {
+		return team.RequiredReviewers.V
+	}
+
+	return u.defaultRequiredReviewers
+}
+
+// collectCandidates возвращает активных участников команды, удовлетворяющих filter,
+// не входящих в exclude, не находящихся в периоде недоступности и не исчерпавших лимит
+// назначений, вместе с их текущей нагрузкой и, если команда учитывает рабочие часы,
+// временем до начала их рабочих часов.
+func (u *UseCase) collectCandidates(
+	ctx context.Context,
+	team data.Team,
+	exclude []uuid.UUID,
+	filter func(data.TeamMember) bool,
+) ([]selector.Candidate, error) {
+	teamMembers, err := u.repo.GetTeamMembersByTeamID(ctx, team.ID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team members by team id",
+			zap.Error(err),
+			zap.String("TeamID", team.ID.String()))
+
+		return nil, err
+	}
+
+	var users []data.User
+
+	for _, tm := range teamMembers {
+		if slices.Contains(exclude, tm.UserID) || !filter(tm) {
+			continue
+		}
+
+		user, err := u.repo.GetUserByID(ctx, tm.UserID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by id",
+				zap.Error(err),
+				zap.String("UserID", tm.UserID.String()))
+
+			continue
+		}
+
+		if user.IsActive {
+			users = append(users, user)
+		}
+	}
+
+	if len(users) == 0 {
+		return nil, nil
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.ID)
+	}
+
+	unavailable, err := u.unavailableUsers(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	loads, err := u.repo.GetReviewersLoad(ctx, userIDs)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting reviewers load", zap.Error(err))
+
+		return nil, err
+	}
+
+	loadByUser := make(map[uuid.UUID]data.ReviewerLoad, len(loads))
+	for _, load := range loads {
+		loadByUser[load.ReviewerID] = load
+	}
+
+	var waits map[uuid.UUID]time.Duration
+
+	if u.teamPreferWorkingHours(team) {
+		waits, err = u.usersUntilWorkingHours(ctx, users)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	candidates := make([]selector.Candidate, 0, len(users))
+	for _, user := range users {
+		if slices.Contains(unavailable, user.ID) {
+			continue
+		}
+
+		load := loadByUser[user.ID]
+
+		if atCapacity(u.reviewerCapacity(user, team), load.OpenReviews) {
+			continue
+		}
+
+		candidates = append(candidates, selector.Candidate{
+			User:              user,
+			OpenReviews:       load.OpenReviews,
+			LastAssignedAt:    load.LastAssignedAt.Time,
+			UntilWorkingHours: waits[user.ID],
+		})
+	}
+
+	return candidates, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"pr-reviewer-assign-service/internal/app/data"
 	"pr-reviewer-assign-service/internal/app/domain/model"
 	"pr-reviewer-assign-service/pkg/log"
 )
@@ -17,6 +18,8 @@ type SetIsActiveParams struct {
 
 type SetIsActiveResult struct {
 	User model.User
+	// HandOff заполняется при деактивации пользователя.
+	HandOff ReviewHandOff
 }
 
 func (u *UseCase) SetIsActive(
@@ -43,30 +46,18 @@ func (u *UseCase) SetIsActive(
 			return fmt.Errorf("failed to update user: %w", err)
 		}
 
-		teamMembers, err := u.repo.GetTeamMembersByUserID(ctx, user.ID)
+		result.HandOff, err = u.applyActivityChange(ctx, user, updatedUser)
 		if err != nil {
-			log.LoggerFromCtx(ctx).Error("error getting team members", zap.Error(err))
-
 			return err
 		}
 
-		if len(teamMembers) == 0 {
-			return fmt.Errorf("user has no team")
-		}
-
-		team, err := u.repo.GetTeamByID(ctx, teamMembers[0].TeamID)
+		teams, err := u.userTeams(ctx, user.ID)
 		if err != nil {
-			log.LoggerFromCtx(ctx).Error("error getting team by id", zap.Error(err))
-
-			return fmt.Errorf("failed to get user team: %w", err)
+			return err
 		}
 
-		result.User = model.User{
-			UserID:   updatedUser.ExternalID,
-			UserName: updatedUser.Username,
-			TeamName: team.Name,
-			IsActive: updatedUser.IsActive,
-		}
+		// Пользователь может остаться без команды после ее удаления - тогда team_name пустой.
+		result.User = userWithPrimaryTeam(updatedUser, teams)
 
 		return nil
 	})
@@ -76,3 +67,42 @@ func (u *UseCase) SetIsActive(
 
 	return result, nil
 }
+
+// applyActivityChange выполняет действия, связанные со сменой флага активности:
+// при деактивации передает открытые ревью пользователя, при активации доназначает его
+// на PR его команд с нехваткой ревьюверов.
+func (u *UseCase) applyActivityChange(
+	ctx context.Context,
+	before, after data.User,
+) (ReviewHandOff, error) {
+	if before.IsActive && !after.IsActive {
+		handOff, err := u.handOffReviews(ctx, after, model.PRReviewerHistoryChangeReasonDeactivation)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error handing off reviews", zap.Error(err))
+
+			return ReviewHandOff{}, fmt.Errorf("failed to hand off reviews: %w", err)
+		}
+
+		return handOff, nil
+	}
+
+	if !before.IsActive && after.IsActive {
+		teamMembers, err := u.repo.GetTeamMembersByUserID(ctx, after.ID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team members", zap.Error(err))
+
+			return ReviewHandOff{}, err
+		}
+
+		for _, tm := range teamMembers {
+			_, err = u.topUpPullRequests(ctx, tm.TeamID, after)
+			if err != nil {
+				log.LoggerFromCtx(ctx).Error("error topping up PRs", zap.Error(err))
+
+				return ReviewHandOff{}, fmt.Errorf("failed to top up PRs: %w", err)
+			}
+		}
+	}
+
+	return ReviewHandOff{}, nil
+}
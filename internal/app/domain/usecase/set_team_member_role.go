@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+type SetTeamMemberRoleParams struct {
+	TeamName string
+	UserID   string
+	Role     model.TeamMemberRole
+}
+
+type SetTeamMemberRoleResult struct {
+	Team model.Team
+}
+
+func (u *UseCase) SetTeamMemberRole(
+	ctx context.Context,
+	params SetTeamMemberRoleParams,
+) (SetTeamMemberRoleResult, error) {
+	if !isKnownRole(params.Role) {
+		return SetTeamMemberRoleResult{}, errors.New(api.ErrUnknownRole)
+	}
+
+	var result SetTeamMemberRoleResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		team, err := u.repo.GetTeamByName(ctx, params.TeamName)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by name",
+				zap.Error(err),
+				zap.String("TeamName", params.TeamName))
+
+			return err
+		}
+
+		user, err := u.repo.GetUserByExternalID(ctx, params.UserID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", params.UserID))
+
+			return err
+		}
+
+		teamMember, err := u.repo.GetTeamMemberByTeamAndUser(ctx, team.ID, user.ID)
+		if errors.Is(err, api.ErrNotFound) {
+			return errors.New(api.ErrNotTeamMember)
+		}
+
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team member", zap.Error(err))
+
+			return err
+		}
+
+		_, err = u.repo.UpdateTeamMemberRole(ctx, teamMember.ID, params.Role)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error updating team member role", zap.Error(err))
+
+			return err
+		}
+
+		members, err := u.teamMembers(ctx, team.ID)
+		if err != nil {
+			return err
+		}
+
+		result.Team, err = u.teamModel(ctx, team, members)
+
+		return err
+	})
+	if err != nil {
+		return SetTeamMemberRoleResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/internal/app/domain/selector"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// SetTeamSettingsParams - настройки назначения ревьюверов команды.
+// nil означает "не менять", пустая строка в ReviewerStrategy - "использовать значение из конфигурации".
+type SetTeamSettingsParams struct {
+	TeamName          string
+	ReviewerStrategy  *string
+	RequiredReviewers *int
+	// MaxOpenReviews - лимит назначений на открытые PR для участников (0 - значение из конфигурации).
+	MaxOpenReviews *int
+	// PreferWorkingHours - выбирать сначала ревьюверов в рабочих часах.
+	PreferWorkingHours *bool
+	LeadRule           *model.TeamLeadRule
+	// FallbackTeams - названия резервных команд в порядке обращения к ним (пустой список - удалить все).
+	FallbackTeams *[]string
+}
+
+type SetTeamSettingsResult struct {
+	Team model.Team
+}
+
+func (u *UseCase) SetTeamSettings(
+	ctx context.Context,
+	params SetTeamSettingsParams,
+) (SetTeamSettingsResult, error) {
+	if params.ReviewerStrategy != nil && *params.ReviewerStrategy != "" &&
+		!selector.IsKnown(*params.ReviewerStrategy) {
+		return SetTeamSettingsResult{}, errors.New(api.ErrUnknownStrategy)
+	}
+
+	if params.RequiredReviewers != nil && *params.RequiredReviewers < 0 {
+		return SetTeamSettingsResult{}, errors.New(api.ErrInvalidRequiredReviewers)
+	}
+
+	if params.MaxOpenReviews != nil && *params.MaxOpenReviews < 0 {
+		return SetTeamSettingsResult{}, errors.New(api.ErrInvalidMaxOpenReviews)
+	}
+
+	if params.LeadRule != nil && !isKnownLeadRule(*params.LeadRule) {
+		return SetTeamSettingsResult{}, errors.New(api.ErrUnknownLeadRule)
+	}
+
+	var result SetTeamSettingsResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		team, err := u.repo.GetTeamByName(ctx, params.TeamName)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by name",
+				zap.Error(err),
+				zap.String("TeamName", params.TeamName))
+
+			return err
+		}
+
+		if params.ReviewerStrategy != nil {
+			team.ReviewerStrategy = sql.Null[string]{
+				V:     *params.ReviewerStrategy,
+				Valid: *params.ReviewerStrategy != "",
+			}
+		}
+
+		if params.RequiredReviewers != nil {
+			team.RequiredReviewers = sql.Null[int]{V: *params.RequiredReviewers, Valid: true}
+		}
+
+		if params.MaxOpenReviews != nil {
+			team.MaxOpenReviews = sql.Null[int]{
+				V:     *params.MaxOpenReviews,
+				Valid: *params.MaxOpenReviews != 0,
+			}
+		}
+
+		if params.PreferWorkingHours != nil {
+			team.PreferWorkingHours = sql.Null[bool]{V: *params.PreferWorkingHours, Valid: true}
+		}
+
+		if params.LeadRule != nil {
+			team.LeadRule = sql.Null[string]{V: *params.LeadRule, Valid: true}
+		}
+
+		updatedTeam, err := u.repo.UpdateTeam(ctx, team)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error updating team", zap.Error(err))
+
+			return fmt.Errorf("failed to update team: %w", err)
+		}
+
+		if params.FallbackTeams != nil {
+			err = u.setTeamFallbacks(ctx, updatedTeam, *params.FallbackTeams)
+			if err != nil {
+				return err
+			}
+		}
+
+		result.Team, err = u.teamModel(ctx, updatedTeam, nil)
+
+		return err
+	})
+	if err != nil {
+		return SetTeamSettingsResult{}, err
+	}
+
+	return result, nil
+}
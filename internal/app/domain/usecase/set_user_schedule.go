@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// SetUserScheduleParams - новый график пользователя; заменяет сохраненный целиком.
+type SetUserScheduleParams struct {
+	UserID string
+	// Timezone - часовой пояс IANA, например Europe/Moscow (пусто - UTC).
+	Timezone string
+	// WorkingHours - рабочие интервалы; пустой список снимает ограничение рабочими часами.
+	WorkingHours []model.WorkingHours
+}
+
+type SetUserScheduleResult struct {
+	Schedule model.UserSchedule
+}
+
+// SetUserSchedule сохраняет часовой пояс и рабочие часы пользователя.
+func (u *UseCase) SetUserSchedule(
+	ctx context.Context,
+	params SetUserScheduleParams,
+) (SetUserScheduleResult, error) {
+	timezone, err := parseTimezone(params.Timezone)
+	if err != nil {
+		return SetUserScheduleResult{}, err
+	}
+
+	hours, err := parseWorkingHours(params.WorkingHours)
+	if err != nil {
+		return SetUserScheduleResult{}, err
+	}
+
+	var result SetUserScheduleResult
+
+	err = u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		user, err := u.repo.GetUserByExternalID(ctx, params.UserID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", params.UserID))
+
+			return err
+		}
+
+		newTimezone := sql.NullString{String: timezone, Valid: timezone != ""}
+		if user.Timezone != newTimezone {
+			user.Timezone = newTimezone
+
+			user, err = u.repo.UpdateUser(ctx, user)
+			if err != nil {
+				log.LoggerFromCtx(ctx).Error("error updating user", zap.Error(err))
+
+				return err
+			}
+		}
+
+		for i := range hours {
+			hours[i].UserID = user.ID
+		}
+
+		err = u.repo.SetUserWorkingHours(ctx, user.ID, hours)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error setting working hours", zap.Error(err))
+
+			return err
+		}
+
+		result.Schedule = toUserSchedule(user, hours, time.Now())
+
+		return nil
+	})
+	if err != nil {
+		return SetUserScheduleResult{}, err
+	}
+
+	return result, nil
+}
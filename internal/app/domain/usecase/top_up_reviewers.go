@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// topUpPullRequests доназначает пользователя на открытые PR команды, которым не хватает
+// ревьюверов (или LEAD, если пользователь - LEAD), пока не исчерпан его лимит назначений,
+// и снимает флаг need_more_reviewers с PR, где ревьюверов стало достаточно.
+// Возвращает PR, на которые пользователь был назначен.
+func (u *UseCase) topUpPullRequests(
+	ctx context.Context,
+	teamID uuid.UUID,
+	user data.User,
+) ([]data.PullRequest, error) {
+	if !user.IsActive {
+		return nil, nil
+	}
+
+	unavailable, err := u.isUnavailable(ctx, user.ID)
+	if err != nil || unavailable {
+		return nil, err
+	}
+
+	team, err := u.repo.GetTeamByID(ctx, teamID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team by id",
+			zap.Error(err),
+			zap.String("TeamID", teamID.String()))
+
+		return nil, err
+	}
+
+	prs, err := u.repo.GetOpenPullRequestsNeedingReviewers(ctx, team.ID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting PRs needing reviewers", zap.Error(err))
+
+		return nil, err
+	}
+
+	requiredReviewers := u.teamRequiredReviewers(team)
+
+	userIsLead, err := u.isTeamLead(ctx, team.ID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if userIsLead && teamLeadRule(team) == model.TeamLeadRuleExclude {
+		return nil, nil
+	}
+
+	openReviews, err := u.reviewerOpenReviews(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := u.reviewerCapacity(user, team)
+
+	var toppedUp []data.PullRequest
+
+	for _, pr := range prs {
+		reviewers, err := u.repo.GetCurrentReviewers(ctx, pr.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get reviewers: %w", err)
+		}
+
+		assigned := len(reviewers)
+
+		never, _, err := u.authorReviewerRules(ctx, pr.AuthorID)
+		if err != nil {
+			return nil, err
+		}
+
+		leadMissing, err := u.leadMissing(ctx, team, reviewerIDsWithout(reviewers, uuid.Nil))
+		if err != nil {
+			return nil, err
+		}
+
+		_, alreadyAssigned := currentReviewer(reviewers, user.ID)
+
+		if (assigned < requiredReviewers || (leadMissing && userIsLead)) &&
+			pr.AuthorID != user.ID &&
+			!atCapacity(capacity, openReviews) &&
+			!slices.Contains(never, user.ID) &&
+			!alreadyAssigned {
+			err = u.addReviewer(
+				ctx,
+				pr.ID,
+				user.ID,
+				team.ID,
+				sql.Null[data.UserInternalID]{},
+				model.PRReviewerHistoryChangeReasonTopUp,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			assigned++
+			openReviews++
+			leadMissing = leadMissing && !userIsLead
+
+			toppedUp = append(toppedUp, pr)
+		}
+
+		if assigned >= requiredReviewers && !leadMissing {
+			pr.NeedMoreReviewers = false
+
+			_, err = u.repo.UpdatePullRequest(ctx, pr)
+			if err != nil {
+				log.LoggerFromCtx(ctx).Error("error updating pr", zap.Error(err))
+
+				return nil, fmt.Errorf("failed to update PR: %w", err)
+			}
+		}
+	}
+
+	return toppedUp, nil
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// UpdateTeamParams - изменяемые атрибуты команды. nil означает "не менять".
+type UpdateTeamParams struct {
+	TeamName    string
+	NewTeamName *string
+	Description *string
+}
+
+type UpdateTeamResult struct {
+	Team model.Team
+}
+
+func (u *UseCase) UpdateTeam(ctx context.Context, params UpdateTeamParams) (UpdateTeamResult, error) {
+	var result UpdateTeamResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		team, err := u.repo.GetTeamByName(ctx, params.TeamName)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by name",
+				zap.Error(err),
+				zap.String("TeamName", params.TeamName))
+
+			return err
+		}
+
+		if params.NewTeamName != nil && *params.NewTeamName != "" {
+			team.Name = *params.NewTeamName
+		}
+
+		if params.Description != nil {
+			team.Description = *params.Description
+		}
+
+		updatedTeam, err := u.repo.UpdateTeam(ctx, team)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error updating team", zap.Error(err))
+
+			return fmt.Errorf("failed to update team: %w", err)
+		}
+
+		members, err := u.teamMembers(ctx, updatedTeam.ID)
+		if err != nil {
+			return err
+		}
+
+		result.Team, err = u.teamModel(ctx, updatedTeam, members)
+
+		return err
+	})
+	if err != nil {
+		return UpdateTeamResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// UpdateUnavailabilityPeriodParams - изменяемые атрибуты периода. nil означает, что атрибут не меняется.
+type UpdateUnavailabilityPeriodParams struct {
+	PeriodID string
+	StartsAt *time.Time
+	EndsAt   *time.Time
+	// Reason - новая причина; пустая строка удаляет причину.
+	Reason *string
+}
+
+type UpdateUnavailabilityPeriodResult struct {
+	Period model.UnavailabilityPeriod
+}
+
+// UpdateUnavailabilityPeriod меняет границы или причину периода недоступности.
+// При переносе начала периода ревью пользователя будут переданы заново после нового начала.
+func (u *UseCase) UpdateUnavailabilityPeriod(
+	ctx context.Context,
+	params UpdateUnavailabilityPeriodParams,
+) (UpdateUnavailabilityPeriodResult, error) {
+	var result UpdateUnavailabilityPeriodResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		period, err := u.getUnavailabilityPeriod(ctx, params.PeriodID)
+		if err != nil {
+			return err
+		}
+
+		if params.StartsAt != nil && !params.StartsAt.Equal(period.StartsAt) {
+			period.StartsAt = *params.StartsAt
+			period.HandedOffAt = sql.NullTime{}
+		}
+
+		if params.EndsAt != nil {
+			period.EndsAt = *params.EndsAt
+		}
+
+		if params.Reason != nil {
+			period.Reason = sql.NullString{String: *params.Reason, Valid: *params.Reason != ""}
+		}
+
+		err = validateUnavailabilityPeriod(period.StartsAt, period.EndsAt)
+		if err != nil {
+			return err
+		}
+
+		period, err = u.repo.UpdateUnavailabilityPeriod(ctx, period)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error updating unavailability period", zap.Error(err))
+
+			return err
+		}
+
+		result.Period, err = u.toUnavailabilityPeriod(ctx, period)
+
+		return err
+	})
+	if err != nil {
+		return UpdateUnavailabilityPeriodResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// UpdateUserParams - изменяемые атрибуты пользователя. nil означает, что атрибут не меняется.
+type UpdateUserParams struct {
+	UserID string
+	// NewUserID - новый внешний ID пользователя.
+	NewUserID *string
+	Username  *string
+	// Email - новый адрес; пустая строка удаляет адрес.
+	Email *string
+	// MaxOpenReviews - лимит назначений на открытые PR; 0 - использовать лимит команды.
+	MaxOpenReviews *int
+}
+
+type UpdateUserResult struct {
+	User  model.User
+	Teams []model.UserTeam
+}
+
+// UpdateUser меняет внешний ID, имя, email и лимит назначений пользователя,
+// проверяя уникальность ID, имени и email.
+func (u *UseCase) UpdateUser(
+	ctx context.Context,
+	params UpdateUserParams,
+) (UpdateUserResult, error) {
+	if params.MaxOpenReviews != nil && *params.MaxOpenReviews < 0 {
+		return UpdateUserResult{}, errors.New(api.ErrInvalidMaxOpenReviews)
+	}
+
+	var result UpdateUserResult
+
+	err := u.txMan.Transactional(ctx, func(ctx context.Context) error {
+		user, err := u.repo.GetUserByExternalID(ctx, params.UserID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting user by external id",
+				zap.Error(err),
+				zap.String("UserID", params.UserID))
+
+			return err
+		}
+
+		updated := user
+
+		if params.NewUserID != nil {
+			updated.ExternalID = strings.TrimSpace(*params.NewUserID)
+			if updated.ExternalID == "" {
+				return errors.New(api.ErrUserIDNotProvided)
+			}
+
+			err = u.ensureUserAttrAvailable(
+				ctx, u.repo.GetUserByExternalID, updated.ExternalID, user.ID, api.ErrUserIDTaken)
+			if err != nil {
+				return err
+			}
+		}
+
+		if params.Username != nil {
+			updated.Username = strings.TrimSpace(*params.Username)
+			if updated.Username == "" {
+				return errors.New(api.ErrInvalidUsername)
+			}
+
+			err = u.ensureUserAttrAvailable(
+				ctx, u.repo.GetUserByName, updated.Username, user.ID, api.ErrUsernameTaken)
+			if err != nil {
+				return err
+			}
+		}
+
+		if params.Email != nil {
+			updated.Email, err = normalizeEmail(*params.Email)
+			if err != nil {
+				return err
+			}
+
+			err = u.ensureEmailAvailable(ctx, updated.Email, user.ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		if params.MaxOpenReviews != nil {
+			updated.MaxOpenReviews = sql.Null[int]{
+				V:     *params.MaxOpenReviews,
+				Valid: *params.MaxOpenReviews != 0,
+			}
+		}
+
+		if updated != user {
+			updated, err = u.repo.UpdateUser(ctx, updated)
+			if err != nil {
+				log.LoggerFromCtx(ctx).Error("error updating user", zap.Error(err))
+
+				return err
+			}
+		}
+
+		result.Teams, err = u.userTeams(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		result.User = userWithPrimaryTeam(updated, result.Teams)
+
+		return nil
+	})
+	if err != nil {
+		return UpdateUserResult{}, err
+	}
+
+	return result, nil
+}
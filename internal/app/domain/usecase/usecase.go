@@ -1,18 +1,79 @@
 package usecase
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/knadh/koanf/v2"
+
 	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/domain/selector"
 	"pr-reviewer-assign-service/pkg/txman"
 )
 
 type UseCase struct {
 	repo  data.Repository
 	txMan txman.Manager
+
+	defaultStrategy          selector.Strategy
+	defaultRequiredReviewers int
+	// defaultMaxOpenReviews - лимит назначений на открытые PR на одного ревьювера (0 - без ограничений).
+	defaultMaxOpenReviews int
+	// defaultPreferWorkingHours - выбирать сначала ревьюверов в рабочих часах для команд без своей настройки.
+	defaultPreferWorkingHours bool
+	selectorOptions           selector.Options
 }
 
-func New(repo data.Repository, txMan txman.Manager) *UseCase {
-	return &UseCase{
-		repo:  repo,
-		txMan: txMan,
+const defaultRequiredReviewers = 2
+
+func New(repo data.Repository, txMan txman.Manager, cfg *koanf.Koanf) (*UseCase, error) {
+	defaultStrategy := cfg.String("strategy")
+	if defaultStrategy == "" {
+		defaultStrategy = selector.StrategyRandom
+	}
+
+	if !selector.IsKnown(defaultStrategy) {
+		return nil, fmt.Errorf("unknown default reviewer selection strategy %q", defaultStrategy)
+	}
+
+	tieBreak := cfg.String("tie_break")
+	if tieBreak == "" {
+		tieBreak = selector.TieBreakDeterministic
+	}
+
+	if !selector.IsKnownTieBreak(tieBreak) {
+		return nil, fmt.Errorf("unknown tie break mode %q", tieBreak)
 	}
+
+	requiredReviewers := defaultRequiredReviewers
+	if cfg.Exists("required_reviewers") {
+		requiredReviewers = cfg.Int("required_reviewers")
+	}
+
+	if requiredReviewers < 0 {
+		return nil, fmt.Errorf("invalid default required reviewers count %d", requiredReviewers)
+	}
+
+	maxOpenReviews := cfg.Int("max_open_reviews")
+	if maxOpenReviews < 0 {
+		return nil, fmt.Errorf("invalid default max open reviews %d", maxOpenReviews)
+	}
+
+	seed := cfg.Int64("seed")
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	return &UseCase{
+		repo:                      repo,
+		txMan:                     txMan,
+		defaultStrategy:           defaultStrategy,
+		defaultRequiredReviewers:  requiredReviewers,
+		defaultMaxOpenReviews:     maxOpenReviews,
+		defaultPreferWorkingHours: cfg.Bool("prefer_working_hours"),
+		selectorOptions: selector.Options{
+			TieBreak: tieBreak,
+			Rand:     selector.NewRand(seed),
+		},
+	}, nil
 }
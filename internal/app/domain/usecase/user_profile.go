@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"net/mail"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// normalizeEmail проверяет формат адреса и приводит его к нижнему регистру.
+// Пустая строка означает, что адрес не указан.
+func normalizeEmail(email string) (sql.NullString, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return sql.NullString{}, nil
+	}
+
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return sql.NullString{}, errors.New(api.ErrInvalidEmail)
+	}
+
+	return sql.NullString{String: strings.ToLower(email), Valid: true}, nil
+}
+
+// ensureEmailAvailable проверяет, что адрес не занят другим пользователем.
+func (u *UseCase) ensureEmailAvailable(
+	ctx context.Context,
+	email sql.NullString,
+	userID uuid.UUID,
+) error {
+	if !email.Valid {
+		return nil
+	}
+
+	return u.ensureUserAttrAvailable(ctx, u.repo.GetUserByEmail, email.String, userID, api.ErrEmailTaken)
+}
+
+// ensureUserAttrAvailable проверяет, что уникальный атрибут value не занят другим пользователем.
+// lookup - поиск пользователя по атрибуту, taken - ошибка, если атрибут занят.
+func (u *UseCase) ensureUserAttrAvailable(
+	ctx context.Context,
+	lookup func(ctx context.Context, value string) (data.User, error),
+	value string,
+	userID uuid.UUID,
+	taken errors.Template,
+) error {
+	owner, err := lookup(ctx, value)
+	if errors.Is(err, api.ErrNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error looking up user", zap.Error(err))
+
+		return err
+	}
+
+	if owner.ID != userID {
+		return errors.New(taken)
+	}
+
+	return nil
+}
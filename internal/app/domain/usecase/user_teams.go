@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// primaryTeamMember возвращает участие в основной команде пользователя. Если основная
+// команда не отмечена, возвращается первое участие.
+func primaryTeamMember(teamMembers []data.TeamMember) (data.TeamMember, bool) {
+	if len(teamMembers) == 0 {
+		return data.TeamMember{}, false
+	}
+
+	for _, tm := range teamMembers {
+		if tm.IsPrimary {
+			return tm, true
+		}
+	}
+
+	return teamMembers[0], true
+}
+
+// userPrimaryTeam возвращает основную команду пользователя.
+func (u *UseCase) userPrimaryTeam(ctx context.Context, userID uuid.UUID) (data.Team, error) {
+	teamMembers, err := u.repo.GetTeamMembersByUserID(ctx, userID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team members", zap.Error(err))
+
+		return data.Team{}, err
+	}
+
+	primary, ok := primaryTeamMember(teamMembers)
+	if !ok {
+		return data.Team{}, fmt.Errorf("user has no team")
+	}
+
+	team, err := u.repo.GetTeamByID(ctx, primary.TeamID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team by id", zap.Error(err))
+
+		return data.Team{}, fmt.Errorf("failed to get user team: %w", err)
+	}
+
+	return team, nil
+}
+
+// userTeams возвращает все участия пользователя в командах.
+func (u *UseCase) userTeams(ctx context.Context, userID uuid.UUID) ([]model.UserTeam, error) {
+	teamMembers, err := u.repo.GetTeamMembersByUserID(ctx, userID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting team members", zap.Error(err))
+
+		return nil, err
+	}
+
+	teams := make([]model.UserTeam, 0, len(teamMembers))
+
+	for _, tm := range teamMembers {
+		team, err := u.repo.GetTeamByID(ctx, tm.TeamID)
+		if err != nil {
+			log.LoggerFromCtx(ctx).Error("error getting team by id", zap.Error(err))
+
+			return nil, fmt.Errorf("failed to get user team: %w", err)
+		}
+
+		teams = append(teams, model.UserTeam{
+			TeamName:  team.Name,
+			Role:      tm.Role,
+			IsPrimary: tm.IsPrimary,
+		})
+	}
+
+	return teams, nil
+}
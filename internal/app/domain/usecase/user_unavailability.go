@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// unavailableUsers возвращает тех из переданных пользователей, кто сейчас в периоде недоступности.
+func (u *UseCase) unavailableUsers(ctx context.Context, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	unavailable, err := u.repo.GetUnavailableUserIDs(ctx, userIDs, time.Now())
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting unavailable users", zap.Error(err))
+
+		return nil, err
+	}
+
+	return unavailable, nil
+}
+
+// isUnavailable проверяет, что пользователь сейчас в периоде недоступности.
+func (u *UseCase) isUnavailable(ctx context.Context, userID uuid.UUID) (bool, error) {
+	unavailable, err := u.unavailableUsers(ctx, []uuid.UUID{userID})
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Contains(unavailable, userID), nil
+}
+
+// validateUnavailabilityPeriod проверяет границы периода недоступности.
+func validateUnavailabilityPeriod(startsAt, endsAt time.Time) error {
+	if startsAt.IsZero() || endsAt.IsZero() || !endsAt.After(startsAt) {
+		return errors.New(api.ErrInvalidUnavailabilityPeriod)
+	}
+
+	return nil
+}
+
+// getUnavailabilityPeriod получает период недоступности по строковому ID.
+func (u *UseCase) getUnavailabilityPeriod(
+	ctx context.Context,
+	periodID string,
+) (data.UnavailabilityPeriod, error) {
+	id, err := uuid.Parse(periodID)
+	if err != nil {
+		return data.UnavailabilityPeriod{}, errors.New(api.ErrNotFound)
+	}
+
+	period, err := u.repo.GetUnavailabilityPeriodByID(ctx, id)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting unavailability period",
+			zap.Error(err),
+			zap.String("PeriodID", periodID))
+
+		return data.UnavailabilityPeriod{}, err
+	}
+
+	return period, nil
+}
+
+// toUnavailabilityPeriod преобразует период недоступности в доменную модель.
+func (u *UseCase) toUnavailabilityPeriod(
+	ctx context.Context,
+	period data.UnavailabilityPeriod,
+) (model.UnavailabilityPeriod, error) {
+	user, err := u.repo.GetUserByID(ctx, period.UserID)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting user by id", zap.Error(err))
+
+		return model.UnavailabilityPeriod{}, err
+	}
+
+	return model.UnavailabilityPeriod{
+		PeriodID:    period.ID.String(),
+		UserID:      user.ExternalID,
+		StartsAt:    period.StartsAt,
+		EndsAt:      period.EndsAt,
+		Reason:      period.Reason.String,
+		HandedOff:   period.HandedOffAt.Valid,
+		ExternalUID: period.ExternalUID.String,
+	}, nil
+}
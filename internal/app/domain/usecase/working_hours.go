@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pr-reviewer-assign-service/internal/app/data"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api"
+	"pr-reviewer-assign-service/internal/app/domain/model"
+	"pr-reviewer-assign-service/pkg/errors"
+	"pr-reviewer-assign-service/pkg/log"
+)
+
+// weekdays - названия дней недели по номеру ISO 8601 (1 - понедельник, 7 - воскресенье).
+var weekdays = []string{"", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+const minutesPerDay = 24 * 60
+
+// usersUntilWorkingHours возвращает для пользователей время до начала их ближайших рабочих часов.
+// Пользователи в рабочих часах и пользователи без рабочих часов в результат не попадают.
+func (u *UseCase) usersUntilWorkingHours(
+	ctx context.Context,
+	users []data.User,
+) (map[uuid.UUID]time.Duration, error) {
+	userIDs := make([]uuid.UUID, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.ID)
+	}
+
+	hours, err := u.repo.GetWorkingHours(ctx, userIDs)
+	if err != nil {
+		log.LoggerFromCtx(ctx).Error("error getting working hours", zap.Error(err))
+
+		return nil, err
+	}
+
+	hoursByUser := make(map[uuid.UUID][]data.WorkingHours)
+	for _, interval := range hours {
+		hoursByUser[interval.UserID] = append(hoursByUser[interval.UserID], interval)
+	}
+
+	now := time.Now()
+	waits := make(map[uuid.UUID]time.Duration)
+
+	for _, user := range users {
+		wait := untilWorkingHours(hoursByUser[user.ID], userLocation(user), now)
+		if wait > 0 {
+			waits[user.ID] = wait
+		}
+	}
+
+	return waits, nil
+}
+
+// untilWorkingHours возвращает время от now до начала ближайшего рабочего интервала:
+// 0, если now попадает в интервал или интервалы не заданы.
+// Интервалы должны быть упорядочены по дню недели и началу.
+func untilWorkingHours(hours []data.WorkingHours, location *time.Location, now time.Time) time.Duration {
+	if len(hours) == 0 {
+		return 0
+	}
+
+	year, month, day := now.In(location).Date()
+
+	// Через неделю повторяется первый интервал того же дня недели, поэтому восьми дней достаточно.
+	for offset := range 8 {
+		weekday := isoWeekday(time.Date(year, month, day+offset, 0, 0, 0, 0, location).Weekday())
+
+		for _, interval := range hours {
+			if interval.Weekday != weekday {
+				continue
+			}
+
+			start := time.Date(year, month, day+offset, 0, interval.StartMinute, 0, 0, location)
+			end := time.Date(year, month, day+offset, 0, interval.EndMinute, 0, 0, location)
+
+			if !now.Before(start) && now.Before(end) {
+				return 0
+			}
+
+			if start.After(now) {
+				return start.Sub(now)
+			}
+		}
+	}
+
+	return 0
+}
+
+// isoWeekday возвращает номер дня недели по ISO 8601.
+func isoWeekday(weekday time.Weekday) int {
+	if weekday == time.Sunday {
+		return 7
+	}
+
+	return int(weekday)
+}
+
+// userLocation возвращает часовой пояс пользователя (UTC, если не задан).
+func userLocation(user data.User) *time.Location {
+	if !user.Timezone.Valid {
+		return time.UTC
+	}
+
+	location, err := time.LoadLocation(user.Timezone.String)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// parseTimezone проверяет название часового пояса IANA. Пустая строка означает UTC.
+func parseTimezone(timezone string) (string, error) {
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" {
+		return "", nil
+	}
+
+	if timezone == "Local" {
+		return "", errors.New(api.ErrInvalidTimezone)
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return "", errors.New(api.ErrInvalidTimezone)
+	}
+
+	return timezone, nil
+}
+
+// parseWorkingHours проверяет рабочие интервалы и упорядочивает их по дню недели и началу.
+// Интервалы одного дня не должны пересекаться.
+func parseWorkingHours(hours []model.WorkingHours) ([]data.WorkingHours, error) {
+	parsed := make([]data.WorkingHours, 0, len(hours))
+
+	for i, interval := range hours {
+		field := fmt.Sprintf("working_hours[%d]", i)
+
+		weekday := slices.Index(weekdays, strings.ToLower(strings.TrimSpace(interval.Weekday)))
+		if weekday <= 0 {
+			return nil, invalidWorkingHours(field, "unknown weekday")
+		}
+
+		start, ok := parseClock(interval.Start)
+		if !ok {
+			return nil, invalidWorkingHours(field, "start must be in HH:MM format")
+		}
+
+		end, ok := parseClock(interval.End)
+		if !ok {
+			return nil, invalidWorkingHours(field, "end must be in HH:MM format")
+		}
+
+		if end <= start {
+			return nil, invalidWorkingHours(field, "end must be after start")
+		}
+
+		parsed = append(parsed, data.WorkingHours{Weekday: weekday, StartMinute: start, EndMinute: end})
+	}
+
+	slices.SortFunc(parsed, func(a, b data.WorkingHours) int {
+		if a.Weekday != b.Weekday {
+			return a.Weekday - b.Weekday
+		}
+
+		return a.StartMinute - b.StartMinute
+	})
+
+	for i := 1; i < len(parsed); i++ {
+		if parsed[i].Weekday == parsed[i-1].Weekday && parsed[i].StartMinute < parsed[i-1].EndMinute {
+			return nil, invalidWorkingHours("working_hours", "intervals of "+weekdays[parsed[i].Weekday]+" overlap")
+		}
+	}
+
+	return parsed, nil
+}
+
+func invalidWorkingHours(field, message string) error {
+	return errors.New(api.ErrInvalidWorkingHours, errors.WithValidationErrors(map[string]string{field: message}))
+}
+
+// parseClock разбирает время дня HH:MM (до 24:00 включительно) в минуты от начала дня.
+func parseClock(value string) (int, bool) {
+	value = strings.TrimSpace(value)
+	if value == "24:00" {
+		return minutesPerDay, true
+	}
+
+	clock, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, false
+	}
+
+	return clock.Hour()*60 + clock.Minute(), true
+}
+
+// toUserSchedule преобразует часовой пояс и рабочие интервалы пользователя в доменную модель.
+func toUserSchedule(user data.User, hours []data.WorkingHours, now time.Time) model.UserSchedule {
+	schedule := model.UserSchedule{
+		UserID:         user.ExternalID,
+		Timezone:       user.Timezone.String,
+		WorkingHours:   make([]model.WorkingHours, 0, len(hours)),
+		InWorkingHours: true,
+	}
+
+	for _, interval := range hours {
+		schedule.WorkingHours = append(schedule.WorkingHours, model.WorkingHours{
+			Weekday: weekdays[interval.Weekday],
+			Start:   fmt.Sprintf("%02d:%02d", interval.StartMinute/60, interval.StartMinute%60),
+			End:     fmt.Sprintf("%02d:%02d", interval.EndMinute/60, interval.EndMinute%60),
+		})
+	}
+
+	if wait := untilWorkingHours(hours, userLocation(user), now); wait > 0 {
+		schedule.InWorkingHours = false
+		schedule.NextWorkingAt = now.Add(wait)
+	}
+
+	return schedule
+}
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS reviewer_strategy VARCHAR(50) NULL;
+
+COMMENT ON COLUMN teams.reviewer_strategy IS 'Стратегия выбора ревьюверов: random, round_robin, least_loaded, weighted (NULL - значение из конфигурации)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE teams DROP COLUMN IF EXISTS reviewer_strategy;
+-- +goose StatementEnd
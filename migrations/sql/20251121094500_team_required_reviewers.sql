@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS required_reviewers INT NULL CHECK (required_reviewers >= 0);
+
+COMMENT ON COLUMN teams.required_reviewers IS 'Количество ревьюверов, назначаемых на PR (NULL - значение из конфигурации)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE teams DROP COLUMN IF EXISTS required_reviewers;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS team_id UUID NULL REFERENCES teams(id);
+
+UPDATE pull_requests pr
+SET team_id = (
+    SELECT tm.team_id
+    FROM team_members tm
+    WHERE tm.user_id = pr.author_id
+    ORDER BY tm.created_at
+    LIMIT 1
+)
+WHERE pr.team_id IS NULL;
+
+COMMENT ON COLUMN pull_requests.team_id IS 'Идентификатор команды, из которой назначаются ревьюверы PR';
+
+CREATE INDEX idx_pull_requests_team_need_more ON pull_requests(team_id) WHERE status = 'OPEN' AND need_more_reviewers = true;
+
+-- Ревьювер, снятый с PR, может быть назначен на него повторно (например, при добавлении
+-- после реактивации), поэтому уникальность нужна только среди текущих назначений.
+ALTER TABLE pr_reviewers DROP CONSTRAINT IF EXISTS pr_reviewers_pr_id_reviewer_id_key;
+CREATE UNIQUE INDEX idx_pr_reviewers_pr_reviewer_current ON pr_reviewers(pr_id, reviewer_id) WHERE is_current = true;
+
+COMMENT ON COLUMN pr_reviewer_history.reason IS 'Причина изменения: initial - первоначальное назначение, reassignment - переназначение, deactivation - деактивация пользователя, top_up - доназначение на PR с нехваткой ревьюверов';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_pr_reviewers_pr_reviewer_current;
+ALTER TABLE pr_reviewers ADD CONSTRAINT pr_reviewers_pr_id_reviewer_id_key UNIQUE (pr_id, reviewer_id);
+
+DROP INDEX IF EXISTS idx_pull_requests_team_need_more;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS team_id;
+
+COMMENT ON COLUMN pr_reviewer_history.reason IS 'Причина изменения: initial - первоначальное назначение, reassignment - переназначение, deactivation - деактивация пользователя';
+-- +goose StatementEnd
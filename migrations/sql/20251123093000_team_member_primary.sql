@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE team_members ADD COLUMN IF NOT EXISTS is_primary BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE team_members tm
+SET is_primary = true
+WHERE tm.id = (
+    SELECT m.id
+    FROM team_members m
+    WHERE m.user_id = tm.user_id
+    ORDER BY m.created_at, m.id
+    LIMIT 1
+);
+
+COMMENT ON COLUMN team_members.is_primary IS 'Флаг основной команды пользователя (из нее по умолчанию назначаются ревьюверы на его PR)';
+
+CREATE UNIQUE INDEX idx_team_members_user_primary ON team_members(user_id) WHERE is_primary = true;
+
+COMMENT ON COLUMN pr_reviewer_history.reason IS 'Причина изменения: initial - первоначальное назначение, reassignment - переназначение, deactivation - деактивация пользователя, top_up - доназначение на PR с нехваткой ревьюверов, team_leave - выход ревьювера из команды';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_team_members_user_primary;
+ALTER TABLE team_members DROP COLUMN IF EXISTS is_primary;
+
+COMMENT ON COLUMN pr_reviewer_history.reason IS 'Причина изменения: initial - первоначальное назначение, reassignment - переназначение, deactivation - деактивация пользователя, top_up - доназначение на PR с нехваткой ревьюверов';
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- При удалении команды история назначений сохраняется, ссылка на команду обнуляется.
+ALTER TABLE pr_reviewers ALTER COLUMN team_id DROP NOT NULL;
+ALTER TABLE pr_reviewers DROP CONSTRAINT IF EXISTS pr_reviewers_team_id_fkey;
+ALTER TABLE pr_reviewers ADD CONSTRAINT pr_reviewers_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE SET NULL;
+
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_team_id_fkey;
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN pr_reviewers.team_id IS 'Идентификатор команды, из которой назначен ревьювер (NULL, если команда удалена)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_team_id_fkey;
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id);
+
+DELETE FROM pr_reviewers WHERE team_id IS NULL;
+ALTER TABLE pr_reviewers DROP CONSTRAINT IF EXISTS pr_reviewers_team_id_fkey;
+ALTER TABLE pr_reviewers ADD CONSTRAINT pr_reviewers_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id);
+ALTER TABLE pr_reviewers ALTER COLUMN team_id SET NOT NULL;
+
+COMMENT ON COLUMN pr_reviewers.team_id IS 'Идентификатор команды, из которой назначен ревьювер';
+-- +goose StatementEnd
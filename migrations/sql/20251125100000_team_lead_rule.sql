@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS lead_rule VARCHAR(20) NULL
+    CHECK (lead_rule IN ('none', 'require_lead', 'exclude_leads'));
+
+COMMENT ON COLUMN teams.lead_rule IS 'Правило назначения LEAD: none - без ограничений, require_lead - среди ревьюверов должен быть LEAD, exclude_leads - LEAD не назначаются автоматически (NULL - none)';
+COMMENT ON COLUMN team_members.role IS 'Роль пользователя в команде (MEMBER, LEAD), учитывается правилом teams.lead_rule';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE teams DROP COLUMN IF EXISTS lead_rule;
+
+COMMENT ON COLUMN team_members.role IS 'Роль пользователя в команде (MEMBER, LEAD, etc)';
+-- +goose StatementEnd
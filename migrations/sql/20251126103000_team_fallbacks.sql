@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS team_fallbacks (
+    team_id UUID REFERENCES teams(id) ON DELETE CASCADE,
+    fallback_team_id UUID REFERENCES teams(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (team_id, fallback_team_id),
+    CHECK (team_id <> fallback_team_id)
+);
+
+COMMENT ON TABLE team_fallbacks IS 'Резервные команды, из которых назначаются ревьюверы, если в команде не осталось кандидатов';
+COMMENT ON COLUMN team_fallbacks.team_id IS 'Идентификатор команды';
+COMMENT ON COLUMN team_fallbacks.fallback_team_id IS 'Идентификатор резервной команды';
+COMMENT ON COLUMN team_fallbacks.position IS 'Порядок обращения к резервной команде (по возрастанию)';
+COMMENT ON COLUMN team_fallbacks.created_at IS 'Время добавления резервной команды';
+
+CREATE INDEX idx_team_fallbacks_team_position ON team_fallbacks(team_id, position);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS team_fallbacks;
+-- +goose StatementEnd
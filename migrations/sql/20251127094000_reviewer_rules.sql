@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reviewer_rules (
+    id UUID PRIMARY KEY,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reviewer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('never_review', 'prefer')),
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (author_id, reviewer_id),
+    CHECK (author_id <> reviewer_id)
+);
+
+COMMENT ON TABLE reviewer_rules IS 'Правила подбора ревьюверов для конкретных авторов';
+COMMENT ON COLUMN reviewer_rules.id IS 'Уникальный идентификатор правила';
+COMMENT ON COLUMN reviewer_rules.author_id IS 'Автор PR, для которого действует правило';
+COMMENT ON COLUMN reviewer_rules.reviewer_id IS 'Ревьювер, к которому относится правило';
+COMMENT ON COLUMN reviewer_rules.kind IS 'Вид правила: never_review - никогда не назначать, prefer - назначать в первую очередь';
+COMMENT ON COLUMN reviewer_rules.created_at IS 'Время создания правила';
+
+CREATE INDEX idx_reviewer_rules_author ON reviewer_rules(author_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reviewer_rules;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+COMMENT ON COLUMN pr_reviewer_history.reason IS 'Причина изменения: initial - первоначальное назначение, reassignment - переназначение, deactivation - деактивация пользователя, top_up - доназначение на PR с нехваткой ревьюверов, team_leave - выход ревьювера из команды, manual - ручное добавление или снятие ревьювера';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+COMMENT ON COLUMN pr_reviewer_history.reason IS 'Причина изменения: initial - первоначальное назначение, reassignment - переназначение, deactivation - деактивация пользователя, top_up - доназначение на PR с нехваткой ревьюверов, team_leave - выход ревьювера из команды';
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pr_reviewer_history ADD COLUMN IF NOT EXISTS comment TEXT NULL;
+
+COMMENT ON COLUMN pr_reviewer_history.changed_by IS 'Пользователь, выполнивший изменение (инициатор запроса; NULL - системное изменение)';
+COMMENT ON COLUMN pr_reviewer_history.comment IS 'Причина изменения в свободной форме, указанная инициатором';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pr_reviewer_history DROP COLUMN IF EXISTS comment;
+
+COMMENT ON COLUMN pr_reviewer_history.changed_by IS 'Идентификатор пользователя, выполнившего изменение';
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_current_assigned
+    ON pr_reviewers(reviewer_id, assigned_at, id)
+    WHERE is_current = true;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_pr_reviewers_current_assigned;
+-- +goose StatementEnd
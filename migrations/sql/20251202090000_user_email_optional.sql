@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ALTER COLUMN email DROP NOT NULL;
+
+-- Сгенерированные при создании команды адреса не принадлежат пользователям
+UPDATE users SET email = NULL WHERE email = external_id || '@example.com';
+
+COMMENT ON COLUMN users.email IS 'Уникальный email пользователя (NULL - не указан)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE users SET email = external_id || '@example.com' WHERE email IS NULL;
+
+ALTER TABLE users ALTER COLUMN email SET NOT NULL;
+
+COMMENT ON COLUMN users.email IS 'Уникальный email пользователя';
+-- +goose StatementEnd
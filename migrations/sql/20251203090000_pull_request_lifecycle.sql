@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pull_requests
+    ADD CONSTRAINT pull_requests_status_check CHECK (status IN ('OPEN', 'MERGED', 'CLOSED', 'DRAFT'));
+
+COMMENT ON COLUMN pull_requests.status IS 'Статус PR: OPEN - открыт, MERGED - смержен, CLOSED - закрыт без мержа, DRAFT - черновик (ревьюверы не назначаются)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE pull_requests SET status = 'OPEN' WHERE status IN ('CLOSED', 'DRAFT');
+
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_status_check;
+
+COMMENT ON COLUMN pull_requests.status IS 'Статус PR: OPEN - открыт, MERGED - смержен';
+-- +goose StatementEnd
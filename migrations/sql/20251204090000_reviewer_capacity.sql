@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS max_open_reviews INT NULL CHECK (max_open_reviews > 0);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS max_open_reviews INT NULL CHECK (max_open_reviews > 0);
+
+COMMENT ON COLUMN teams.max_open_reviews IS 'Лимит назначений на открытые PR для участников команды (NULL - значение из конфигурации)';
+COMMENT ON COLUMN users.max_open_reviews IS 'Лимит назначений пользователя на открытые PR (NULL - лимит команды)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS max_open_reviews;
+ALTER TABLE teams DROP COLUMN IF EXISTS max_open_reviews;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_unavailability (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    reason VARCHAR(255) NULL,
+    handed_off_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    CHECK (ends_at > starts_at)
+);
+
+COMMENT ON TABLE user_unavailability IS 'Периоды недоступности пользователей (отпуск, отсутствие), в которые они не назначаются ревьюверами';
+COMMENT ON COLUMN user_unavailability.id IS 'Уникальный идентификатор периода';
+COMMENT ON COLUMN user_unavailability.user_id IS 'Недоступный пользователь';
+COMMENT ON COLUMN user_unavailability.starts_at IS 'Начало периода (включительно)';
+COMMENT ON COLUMN user_unavailability.ends_at IS 'Конец периода (не включительно)';
+COMMENT ON COLUMN user_unavailability.reason IS 'Причина недоступности в свободной форме';
+COMMENT ON COLUMN user_unavailability.handed_off_at IS 'Время передачи открытых ревью пользователя другим ревьюверам после начала периода (NULL - еще не передавались)';
+COMMENT ON COLUMN user_unavailability.created_at IS 'Время создания записи';
+COMMENT ON COLUMN user_unavailability.updated_at IS 'Время последнего обновления записи';
+
+CREATE INDEX idx_user_unavailability_user ON user_unavailability(user_id, ends_at);
+CREATE INDEX idx_user_unavailability_pending_hand_off ON user_unavailability(starts_at)
+    WHERE handed_off_at IS NULL;
+
+COMMENT ON COLUMN pr_reviewer_history.reason IS 'Причина изменения: initial - первоначальное назначение, reassignment - переназначение, deactivation - деактивация пользователя, top_up - доназначение на PR с нехваткой ревьюверов, team_leave - выход ревьювера из команды, manual - ручное добавление или снятие ревьювера, unavailable - передача ревью после начала периода недоступности';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_unavailability;
+
+COMMENT ON COLUMN pr_reviewer_history.reason IS 'Причина изменения: initial - первоначальное назначение, reassignment - переназначение, deactivation - деактивация пользователя, top_up - доназначение на PR с нехваткой ревьюверов, team_leave - выход ревьювера из команды, manual - ручное добавление или снятие ревьювера';
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_unavailability ADD COLUMN IF NOT EXISTS external_uid VARCHAR(255) NULL;
+
+COMMENT ON COLUMN user_unavailability.external_uid IS 'UID события календаря, из которого импортирован период (NULL - период добавлен вручную)';
+
+CREATE UNIQUE INDEX idx_user_unavailability_external_uid ON user_unavailability(external_uid, user_id)
+    WHERE external_uid IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_user_unavailability_external_uid;
+
+ALTER TABLE user_unavailability DROP COLUMN IF EXISTS external_uid;
+-- +goose StatementEnd
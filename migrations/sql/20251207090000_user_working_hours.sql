@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NULL;
+
+COMMENT ON COLUMN users.timezone IS 'Часовой пояс IANA, в котором задан график работы пользователя (NULL - UTC)';
+
+CREATE TABLE IF NOT EXISTS user_working_hours (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 1 AND 7),
+    starts_at TIME NOT NULL,
+    ends_at TIME NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (user_id, weekday, starts_at),
+    CHECK (ends_at > starts_at)
+);
+
+COMMENT ON TABLE user_working_hours IS 'Рабочие часы пользователей; пользователь без записей считается доступным в любое время';
+COMMENT ON COLUMN user_working_hours.user_id IS 'Пользователь';
+COMMENT ON COLUMN user_working_hours.weekday IS 'День недели по ISO 8601: 1 - понедельник, 7 - воскресенье';
+COMMENT ON COLUMN user_working_hours.starts_at IS 'Начало рабочего интервала в часовом поясе пользователя (включительно)';
+COMMENT ON COLUMN user_working_hours.ends_at IS 'Конец рабочего интервала в часовом поясе пользователя (не включительно, 24:00 - до конца дня)';
+COMMENT ON COLUMN user_working_hours.created_at IS 'Время создания записи';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_working_hours;
+
+ALTER TABLE users DROP COLUMN IF EXISTS timezone;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS prefer_working_hours BOOLEAN NULL;
+
+COMMENT ON COLUMN teams.prefer_working_hours IS 'Выбирать сначала ревьюверов в рабочих часах (NULL - значение из конфигурации)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE teams DROP COLUMN IF EXISTS prefer_working_hours;
+-- +goose StatementEnd
@@ -3,6 +3,8 @@ package e2e
 import (
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"pr-reviewer-assign-service/internal/app/delivery/http/api"
 	"pr-reviewer-assign-service/internal/app/delivery/http/api/health"
 	"pr-reviewer-assign-service/internal/app/delivery/http/api/pullrequests"
+	"pr-reviewer-assign-service/internal/app/delivery/http/api/rules"
 	"pr-reviewer-assign-service/internal/app/delivery/http/api/statistics"
 	"pr-reviewer-assign-service/internal/app/delivery/http/api/teams"
 	"pr-reviewer-assign-service/internal/app/delivery/http/api/users"
@@ -274,7 +277,8 @@ func (s *E2ETestSuite) TestErrorScenarios() {
 	})
 	s.NoError(err)
 
-	_, err = s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+	// Повторное добавление команды - upsert, а не ошибка
+	upsertResult, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
 		TeamName: teamName,
 		Members: []teams.AddTeamParamsUser{
 			{
@@ -284,8 +288,9 @@ func (s *E2ETestSuite) TestErrorScenarios() {
 			},
 		},
 	})
-	s.Error(err)
-	s.Contains(err.Error(), "TEAM_EXISTS")
+	s.NoError(err)
+	s.False(upsertResult.Diff.TeamCreated)
+	s.Equal([]string{userID + " "}, upsertResult.Diff.CreatedUsers)
 
 	// Попытка получить несуществующую команду
 	_, err = s.apiClient.Teams().GetTeam(s.T().Context(), teams.GetTeamParams{
@@ -348,3 +353,2179 @@ func (s *E2ETestSuite) TestIdempotentMerge() {
 
 	s.Equal(firstResult.PR.MergedAt, secondResult.PR.MergedAt)
 }
+
+// TestLeastLoadedAssignment проверяет, что стратегия least_loaded распределяет ревью равномерно
+func (s *E2ETestSuite) TestLeastLoadedAssignment() {
+	teamName := fmt.Sprintf("team-least-loaded-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-least-loaded-%d", time.Now().UnixNano())
+
+	members := []teams.AddTeamParamsUser{
+		{
+			UserID:   authorID,
+			UserName: fmt.Sprintf("Least Loaded Author %d", time.Now().UnixNano()),
+			IsActive: true,
+		},
+	}
+
+	reviewerIDs := make([]string, 0, 3)
+	for i := range 3 {
+		reviewerID := fmt.Sprintf("reviewer-least-loaded-%d-%d", i, time.Now().UnixNano())
+		reviewerIDs = append(reviewerIDs, reviewerID)
+		members = append(members, teams.AddTeamParamsUser{
+			UserID:   reviewerID,
+			UserName: fmt.Sprintf("Least Loaded Reviewer %d %d", i, time.Now().UnixNano()),
+			IsActive: true,
+		})
+	}
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:         teamName,
+		ReviewerStrategy: "least_loaded",
+		Members:          members,
+	})
+	s.NoError(err)
+
+	for i := range 3 {
+		_, err = s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+			PullRequestID:   fmt.Sprintf("pr-least-loaded-%d-%d", i, time.Now().UnixNano()),
+			PullRequestName: fmt.Sprintf("Least Loaded PR %d", i),
+			AuthorID:        authorID,
+		})
+		s.NoError(err)
+	}
+
+	for _, reviewerID := range reviewerIDs {
+		reviewResult, err := s.apiClient.Users().
+			GetReviewPRs(s.T().Context(), users.GetReviewPRsParams{UserID: reviewerID})
+		s.NoError(err)
+		s.Len(reviewResult.PullRequests, 2)
+	}
+}
+
+// TestRequiredReviewers проверяет настройку количества ревьюверов и флаг need_more_reviewers
+func (s *E2ETestSuite) TestRequiredReviewers() {
+	teamName := fmt.Sprintf("team-required-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-required-%d", time.Now().UnixNano())
+	reviewerID := fmt.Sprintf("reviewer-required-%d", time.Now().UnixNano())
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: teamName,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Required Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewerID,
+				UserName: fmt.Sprintf("Required Reviewer %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	requiredReviewers := 1
+	settingsResult, err := s.apiClient.Teams().
+		SetTeamSettings(s.T().Context(), teams.SetTeamSettingsParams{
+			TeamName:          teamName,
+			RequiredReviewers: &requiredReviewers,
+		})
+	s.NoError(err)
+	s.Equal(1, settingsResult.Team.RequiredReviewers)
+
+	createResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   fmt.Sprintf("pr-required-1-%d", time.Now().UnixNano()),
+		PullRequestName: "Enough reviewers",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Len(createResult.PR.AssignedReviewers, 1)
+	s.False(createResult.PR.NeedMoreReviewers)
+
+	requiredReviewers = 3
+	_, err = s.apiClient.Teams().SetTeamSettings(s.T().Context(), teams.SetTeamSettingsParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+	})
+	s.NoError(err)
+
+	createResult, err = s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   fmt.Sprintf("pr-required-2-%d", time.Now().UnixNano()),
+		PullRequestName: "Not enough reviewers",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Len(createResult.PR.AssignedReviewers, 1)
+	s.True(createResult.PR.NeedMoreReviewers)
+}
+
+// TestTopUpOnReactivation проверяет доназначение реактивированного пользователя на PR с нехваткой ревьюверов
+func (s *E2ETestSuite) TestTopUpOnReactivation() {
+	teamName := fmt.Sprintf("team-top-up-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-top-up-%d", time.Now().UnixNano())
+	reviewerID := fmt.Sprintf("reviewer-top-up-%d", time.Now().UnixNano())
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: teamName,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Top Up Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewerID,
+				UserName: fmt.Sprintf("Top Up Reviewer %d", time.Now().UnixNano()),
+				IsActive: false,
+			},
+		},
+	})
+	s.NoError(err)
+
+	prID := fmt.Sprintf("pr-top-up-%d", time.Now().UnixNano())
+	createResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   prID,
+		PullRequestName: "Top Up PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Empty(createResult.PR.AssignedReviewers)
+	s.True(createResult.PR.NeedMoreReviewers)
+
+	_, err = s.apiClient.Users().SetIsActive(s.T().Context(), users.SetIsActiveParams{
+		UserID:   reviewerID,
+		IsActive: true,
+	})
+	s.NoError(err)
+
+	reviewResult, err := s.apiClient.Users().
+		GetReviewPRs(s.T().Context(), users.GetReviewPRsParams{UserID: reviewerID})
+	s.NoError(err)
+	s.Len(reviewResult.PullRequests, 1)
+	s.Equal(prID, reviewResult.PullRequests[0].PullRequestID)
+}
+
+// TestHandOffOnDeactivation проверяет передачу открытых ревью при деактивации пользователя
+func (s *E2ETestSuite) TestHandOffOnDeactivation() {
+	teamName := fmt.Sprintf("team-hand-off-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-hand-off-%d", time.Now().UnixNano())
+	reviewerID := fmt.Sprintf("reviewer-hand-off-%d", time.Now().UnixNano())
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: teamName,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Hand Off Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewerID,
+				UserName: fmt.Sprintf("Hand Off Reviewer %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	prID := fmt.Sprintf("pr-hand-off-%d", time.Now().UnixNano())
+	createResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   prID,
+		PullRequestName: "Hand Off PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Equal([]string{reviewerID}, createResult.PR.AssignedReviewers)
+
+	setResult, err := s.apiClient.Users().SetIsActive(s.T().Context(), users.SetIsActiveParams{
+		UserID:   reviewerID,
+		IsActive: false,
+	})
+	s.NoError(err)
+	s.Empty(setResult.ReassignedPullRequests)
+	s.Equal([]string{prID}, setResult.NoCandidatePullRequests)
+
+	reviewResult, err := s.apiClient.Users().
+		GetReviewPRs(s.T().Context(), users.GetReviewPRsParams{UserID: reviewerID})
+	s.NoError(err)
+	s.Empty(reviewResult.PullRequests)
+}
+
+// TestBulkDeactivation проверяет массовую деактивацию и отчет по PR без замены
+func (s *E2ETestSuite) TestBulkDeactivation() {
+	teamName := fmt.Sprintf("team-bulk-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-bulk-%d", time.Now().UnixNano())
+	reviewerID := fmt.Sprintf("reviewer-bulk-%d", time.Now().UnixNano())
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: teamName,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Bulk Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewerID,
+				UserName: fmt.Sprintf("Bulk Reviewer %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	prID := fmt.Sprintf("pr-bulk-%d", time.Now().UnixNano())
+	createResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   prID,
+		PullRequestName: "Bulk PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Equal([]string{reviewerID}, createResult.PR.AssignedReviewers)
+
+	bulkResult, err := s.apiClient.Users().
+		BulkSetIsActive(s.T().Context(), users.BulkSetIsActiveParams{
+			UserIDs:  []string{reviewerID, reviewerID},
+			IsActive: false,
+		})
+	s.NoError(err)
+	s.Len(bulkResult.Users, 1)
+	s.True(bulkResult.Users[0].Changed)
+	s.False(bulkResult.Users[0].IsActive)
+	s.Empty(bulkResult.Users[0].ReassignedPullRequests)
+	s.Equal([]string{prID}, bulkResult.Users[0].NoCandidatePullRequests)
+
+	_, err = s.apiClient.Users().
+		BulkSetIsActive(s.T().Context(), users.BulkSetIsActiveParams{IsActive: false})
+	s.Error(err)
+}
+
+// TestMultiTeamMembership проверяет выбор команды PR и управление участием пользователя в командах
+func (s *E2ETestSuite) TestMultiTeamMembership() {
+	primaryTeamName := fmt.Sprintf("team-primary-%d", time.Now().UnixNano())
+	secondTeamName := fmt.Sprintf("team-second-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-multi-%d", time.Now().UnixNano())
+	primaryReviewerID := fmt.Sprintf("reviewer-primary-%d", time.Now().UnixNano())
+	secondReviewerID := fmt.Sprintf("reviewer-second-%d", time.Now().UnixNano())
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: primaryTeamName,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Multi Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   primaryReviewerID,
+				UserName: fmt.Sprintf("Primary Reviewer %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: secondTeamName,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   secondReviewerID,
+				UserName: fmt.Sprintf("Second Reviewer %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   fmt.Sprintf("pr-multi-foreign-%d", time.Now().UnixNano()),
+		PullRequestName: "Foreign Team PR",
+		AuthorID:        authorID,
+		TeamName:        secondTeamName,
+	})
+	s.Error(err)
+
+	addResult, err := s.apiClient.Users().AddUserTeam(s.T().Context(), users.AddUserTeamParams{
+		UserID:   authorID,
+		TeamName: secondTeamName,
+	})
+	s.NoError(err)
+	s.Len(addResult.User.Teams, 2)
+	s.Equal(primaryTeamName, addResult.User.TeamName)
+
+	secondResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   fmt.Sprintf("pr-multi-second-%d", time.Now().UnixNano()),
+		PullRequestName: "Second Team PR",
+		AuthorID:        authorID,
+		TeamName:        secondTeamName,
+	})
+	s.NoError(err)
+	s.Equal([]string{secondReviewerID}, secondResult.PR.AssignedReviewers)
+
+	primaryResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   fmt.Sprintf("pr-multi-primary-%d", time.Now().UnixNano()),
+		PullRequestName: "Primary Team PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Equal([]string{primaryReviewerID}, primaryResult.PR.AssignedReviewers)
+
+	statsResult, err := s.apiClient.Statistics().
+		GetStatistics(s.T().Context(), statistics.GetStatisticsParams{})
+	s.NoError(err)
+
+	var authorEntries int
+
+	for _, assignment := range statsResult.UserAssignments {
+		if assignment.UserID == authorID {
+			authorEntries++
+		}
+	}
+
+	s.Equal(1, authorEntries)
+
+	for _, teamStats := range statsResult.TeamStats {
+		if teamStats.TeamName == primaryTeamName || teamStats.TeamName == secondTeamName {
+			s.Equal(int64(1), teamStats.TotalPRs, teamStats.TeamName)
+		}
+	}
+
+	removeResult, err := s.apiClient.Users().
+		RemoveUserTeam(s.T().Context(), users.RemoveUserTeamParams{
+			UserID:   authorID,
+			TeamName: primaryTeamName,
+		})
+	s.NoError(err)
+	s.Len(removeResult.User.Teams, 1)
+	s.Equal(secondTeamName, removeResult.User.TeamName)
+	s.True(removeResult.User.Teams[0].IsPrimary)
+}
+
+// TestTeamUpsert проверяет обновление существующих пользователей и исключение отсутствующих участников
+func (s *E2ETestSuite) TestTeamUpsert() {
+	teamName := fmt.Sprintf("team-upsert-%d", time.Now().UnixNano())
+	keptID := fmt.Sprintf("user-upsert-kept-%d", time.Now().UnixNano())
+	removedID := fmt.Sprintf("user-upsert-removed-%d", time.Now().UnixNano())
+
+	createResult, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: teamName,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   keptID,
+				UserName: fmt.Sprintf("Upsert Kept %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   removedID,
+				UserName: fmt.Sprintf("Upsert Removed %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+	s.True(createResult.Diff.TeamCreated)
+	s.Len(createResult.Diff.CreatedUsers, 2)
+	s.Len(createResult.Diff.AddedMembers, 2)
+
+	newName := fmt.Sprintf("Upsert Renamed %d", time.Now().UnixNano())
+
+	upsertResult, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: teamName,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   keptID,
+				UserName: newName,
+				IsActive: false,
+			},
+		},
+		RemoveMissing: true,
+	})
+	s.NoError(err)
+	s.False(upsertResult.Diff.TeamCreated)
+	s.Empty(upsertResult.Diff.CreatedUsers)
+	s.Equal([]string{keptID}, upsertResult.Diff.UpdatedUsers)
+	s.Equal([]string{removedID}, upsertResult.Diff.RemovedMembers)
+	s.Len(upsertResult.Team.Members, 1)
+	s.Equal(newName, upsertResult.Team.Members[0].UserName)
+	s.False(upsertResult.Team.Members[0].IsActive)
+}
+
+// TestTeamUpsertRemovesMembersTogether проверяет, что ревью исключаемых участников
+// не передаются тем, кто исключается в этом же запросе
+func (s *E2ETestSuite) TestTeamUpsertRemovesMembersTogether() {
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("team-upsert-together-%d", suffix)
+	authorID := fmt.Sprintf("author-upsert-together-%d", suffix)
+	firstID := fmt.Sprintf("first-upsert-together-%d", suffix)
+	secondID := fmt.Sprintf("second-upsert-together-%d", suffix)
+	newcomerID := fmt.Sprintf("newcomer-upsert-together-%d", suffix)
+	requiredReviewers := 1
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+		Members: []teams.AddTeamParamsUser{
+			{UserID: authorID, UserName: fmt.Sprintf("Together Author %d", suffix), IsActive: true},
+			{UserID: firstID, UserName: fmt.Sprintf("Together First %d", suffix), IsActive: true},
+			{UserID: secondID, UserName: fmt.Sprintf("Together Second %d", suffix), IsActive: true},
+		},
+	})
+	s.NoError(err)
+
+	prID := fmt.Sprintf("pr-upsert-together-%d", suffix)
+
+	createResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   prID,
+		PullRequestName: "Together PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Require().Len(createResult.PR.AssignedReviewers, 1)
+
+	upsertResult, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: teamName,
+		Members: []teams.AddTeamParamsUser{
+			{UserID: authorID, UserName: fmt.Sprintf("Together Author %d", suffix), IsActive: true},
+			{UserID: newcomerID, UserName: fmt.Sprintf("Together Newcomer %d", suffix), IsActive: true},
+		},
+		RemoveMissing: true,
+	})
+	s.NoError(err)
+	s.ElementsMatch([]string{firstID, secondID}, upsertResult.Diff.RemovedMembers)
+	s.Require().Len(upsertResult.Diff.ReassignedPullRequests, 1)
+	s.Equal(prID, upsertResult.Diff.ReassignedPullRequests[0].PullRequestID)
+	s.Equal(createResult.PR.AssignedReviewers[0], upsertResult.Diff.ReassignedPullRequests[0].OldReviewerID)
+	s.Equal(newcomerID, upsertResult.Diff.ReassignedPullRequests[0].ReplacedBy)
+}
+
+// TestTeamManagement проверяет переименование, управление участниками и удаление команды
+func (s *E2ETestSuite) TestTeamManagement() {
+	teamName := fmt.Sprintf("team-manage-%d", time.Now().UnixNano())
+	renamedTeamName := fmt.Sprintf("team-manage-renamed-%d", time.Now().UnixNano())
+	otherTeamName := fmt.Sprintf("team-manage-other-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-manage-%d", time.Now().UnixNano())
+	reviewerID := fmt.Sprintf("reviewer-manage-%d", time.Now().UnixNano())
+	newcomerID := fmt.Sprintf("newcomer-manage-%d", time.Now().UnixNano())
+
+	requiredReviewers := 1
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Manage Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewerID,
+				UserName: fmt.Sprintf("Manage Reviewer %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: otherTeamName,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   newcomerID,
+				UserName: fmt.Sprintf("Manage Newcomer %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	updateResult, err := s.apiClient.Teams().UpdateTeam(s.T().Context(), teams.UpdateTeamParams{
+		TeamName:    teamName,
+		NewTeamName: &renamedTeamName,
+	})
+	s.NoError(err)
+	s.Equal(renamedTeamName, updateResult.Team.TeamName)
+
+	prID := fmt.Sprintf("pr-manage-%d", time.Now().UnixNano())
+	createResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   prID,
+		PullRequestName: "Manage PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Equal([]string{reviewerID}, createResult.PR.AssignedReviewers)
+
+	addResult, err := s.apiClient.Teams().AddTeamMember(s.T().Context(), teams.AddTeamMemberParams{
+		TeamName: renamedTeamName,
+		UserID:   newcomerID,
+	})
+	s.NoError(err)
+	s.Len(addResult.Team.Members, 3)
+
+	_, err = s.apiClient.Teams().AddTeamMember(s.T().Context(), teams.AddTeamMemberParams{
+		TeamName: renamedTeamName,
+		UserID:   newcomerID,
+	})
+	s.Error(err)
+	s.Contains(err.Error(), "TEAM_MEMBER_EXISTS")
+
+	_, err = s.apiClient.Teams().SetTeamMemberRole(s.T().Context(), teams.SetTeamMemberRoleParams{
+		TeamName: renamedTeamName,
+		UserID:   newcomerID,
+		Role:     "OWNER",
+	})
+	s.Error(err)
+	s.Contains(err.Error(), "UNKNOWN_ROLE")
+
+	roleResult, err := s.apiClient.Teams().
+		SetTeamMemberRole(s.T().Context(), teams.SetTeamMemberRoleParams{
+			TeamName: renamedTeamName,
+			UserID:   newcomerID,
+			Role:     "LEAD",
+		})
+	s.NoError(err)
+	for _, member := range roleResult.Team.Members {
+		if member.UserID == newcomerID {
+			s.Equal("LEAD", member.Role)
+		}
+	}
+
+	removeResult, err := s.apiClient.Teams().
+		RemoveTeamMember(s.T().Context(), teams.RemoveTeamMemberParams{
+			TeamName: renamedTeamName,
+			UserID:   reviewerID,
+		})
+	s.NoError(err)
+	s.Len(removeResult.Team.Members, 2)
+	s.Len(removeResult.ReassignedPullRequests, 1)
+	s.Equal(newcomerID, removeResult.ReassignedPullRequests[0].ReplacedBy)
+
+	deleteResult, err := s.apiClient.Teams().DeleteTeam(s.T().Context(), teams.DeleteTeamParams{
+		TeamName: renamedTeamName,
+	})
+	s.NoError(err)
+	s.Len(deleteResult.RemovedMembers, 2)
+	s.Empty(deleteResult.ReassignedPullRequests)
+	s.Equal([]string{prID}, deleteResult.NoCandidatePullRequests)
+
+	_, err = s.apiClient.Teams().
+		GetTeam(s.T().Context(), teams.GetTeamParams{TeamName: renamedTeamName})
+	s.Error(err)
+	s.Contains(err.Error(), "NOT_FOUND")
+}
+
+// TestLeadRule проверяет правила назначения LEAD: обязательный LEAD и исключение LEAD
+func (s *E2ETestSuite) TestLeadRule() {
+	teamName := fmt.Sprintf("team-lead-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-lead-%d", time.Now().UnixNano())
+	memberID := fmt.Sprintf("member-lead-%d", time.Now().UnixNano())
+	leadID := fmt.Sprintf("lead-lead-%d", time.Now().UnixNano())
+	requiredReviewers := 1
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+		LeadRule:          "require_lead",
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Lead Rule Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   memberID,
+				UserName: fmt.Sprintf("Lead Rule Member %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   leadID,
+				UserName: fmt.Sprintf("Lead Rule Lead %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.Teams().SetTeamMemberRole(s.T().Context(), teams.SetTeamMemberRoleParams{
+		TeamName: teamName,
+		UserID:   leadID,
+		Role:     "LEAD",
+	})
+	s.NoError(err)
+
+	requireResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   fmt.Sprintf("pr-lead-require-%d", time.Now().UnixNano()),
+		PullRequestName: "Require Lead PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Equal([]string{leadID}, requireResult.PR.AssignedReviewers)
+	s.False(requireResult.PR.NeedMoreReviewers)
+
+	excludeLeads := "exclude_leads"
+	_, err = s.apiClient.Teams().SetTeamSettings(s.T().Context(), teams.SetTeamSettingsParams{
+		TeamName: teamName,
+		LeadRule: &excludeLeads,
+	})
+	s.NoError(err)
+
+	excludeResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   fmt.Sprintf("pr-lead-exclude-%d", time.Now().UnixNano()),
+		PullRequestName: "Exclude Leads PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Equal([]string{memberID}, excludeResult.PR.AssignedReviewers)
+}
+
+// TestAddTeamMemberLead проверяет, что LEAD, добавленный в команду, доназначается на PR
+// с учетом своей роли
+func (s *E2ETestSuite) TestAddTeamMemberLead() {
+	requireTeamName := fmt.Sprintf("team-add-lead-require-%d", time.Now().UnixNano())
+	excludeTeamName := fmt.Sprintf("team-add-lead-exclude-%d", time.Now().UnixNano())
+	otherTeamName := fmt.Sprintf("team-add-lead-other-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-add-lead-%d", time.Now().UnixNano())
+	memberID := fmt.Sprintf("member-add-lead-%d", time.Now().UnixNano())
+	leadID := fmt.Sprintf("lead-add-lead-%d", time.Now().UnixNano())
+	requiredReviewers := 1
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          requireTeamName,
+		RequiredReviewers: &requiredReviewers,
+		LeadRule:          "require_lead",
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Add Lead Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   memberID,
+				UserName: fmt.Sprintf("Add Lead Member %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: otherTeamName,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   leadID,
+				UserName: fmt.Sprintf("Add Lead Lead %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	requirePRID := fmt.Sprintf("pr-add-lead-require-%d", time.Now().UnixNano())
+	requireResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   requirePRID,
+		PullRequestName: "Add Lead Require PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Equal([]string{memberID}, requireResult.PR.AssignedReviewers)
+	s.True(requireResult.PR.NeedMoreReviewers)
+
+	_, err = s.apiClient.Teams().AddTeamMember(s.T().Context(), teams.AddTeamMemberParams{
+		TeamName: requireTeamName,
+		UserID:   leadID,
+		Role:     "LEAD",
+	})
+	s.NoError(err)
+
+	requireGet, err := s.apiClient.PR().GetPR(s.T().Context(), pullrequests.GetPRParams{
+		PullRequestID: requirePRID,
+	})
+	s.NoError(err)
+	s.ElementsMatch([]string{memberID, leadID}, requireGet.PR.AssignedReviewers)
+	s.False(requireGet.PR.NeedMoreReviewers)
+
+	_, err = s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          excludeTeamName,
+		RequiredReviewers: &requiredReviewers,
+		LeadRule:          "exclude_leads",
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Add Lead Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	excludePRID := fmt.Sprintf("pr-add-lead-exclude-%d", time.Now().UnixNano())
+	_, err = s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   excludePRID,
+		PullRequestName: "Add Lead Exclude PR",
+		AuthorID:        authorID,
+		TeamName:        excludeTeamName,
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.Teams().AddTeamMember(s.T().Context(), teams.AddTeamMemberParams{
+		TeamName: excludeTeamName,
+		UserID:   leadID,
+		Role:     "LEAD",
+	})
+	s.NoError(err)
+
+	excludeGet, err := s.apiClient.PR().GetPR(s.T().Context(), pullrequests.GetPRParams{
+		PullRequestID: excludePRID,
+	})
+	s.NoError(err)
+	s.Empty(excludeGet.PR.AssignedReviewers)
+	s.True(excludeGet.PR.NeedMoreReviewers)
+}
+
+func (s *E2ETestSuite) TestFallbackTeams() {
+	homeTeamName := fmt.Sprintf("team-home-%d", time.Now().UnixNano())
+	fallbackTeamName := fmt.Sprintf("team-fallback-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-home-%d", time.Now().UnixNano())
+	fallbackReviewerID := fmt.Sprintf("reviewer-fallback-%d", time.Now().UnixNano())
+	requiredReviewers := 1
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          homeTeamName,
+		RequiredReviewers: &requiredReviewers,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Home Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: fallbackTeamName,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   fallbackReviewerID,
+				UserName: fmt.Sprintf("Fallback Reviewer %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	fallbackTeams := []string{fallbackTeamName}
+	settingsResult, err := s.apiClient.Teams().SetTeamSettings(s.T().Context(), teams.SetTeamSettingsParams{
+		TeamName:      homeTeamName,
+		FallbackTeams: &fallbackTeams,
+	})
+	s.NoError(err)
+	s.Equal(fallbackTeams, settingsResult.Team.FallbackTeams)
+
+	selfFallback := []string{homeTeamName}
+	_, err = s.apiClient.Teams().SetTeamSettings(s.T().Context(), teams.SetTeamSettingsParams{
+		TeamName:      homeTeamName,
+		FallbackTeams: &selfFallback,
+	})
+	s.Error(err)
+
+	prResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   fmt.Sprintf("pr-fallback-%d", time.Now().UnixNano()),
+		PullRequestName: "Fallback PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Equal([]string{fallbackReviewerID}, prResult.PR.AssignedReviewers)
+	s.False(prResult.PR.NeedMoreReviewers)
+
+	teamResult, err := s.apiClient.Teams().GetTeam(s.T().Context(), teams.GetTeamParams{
+		TeamName: homeTeamName,
+	})
+	s.NoError(err)
+	s.Equal(fallbackTeams, teamResult.FallbackTeams)
+}
+
+func (s *E2ETestSuite) TestReviewerRules() {
+	teamName := fmt.Sprintf("team-rules-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-rules-%d", time.Now().UnixNano())
+	blockedID := fmt.Sprintf("blocked-rules-%d", time.Now().UnixNano())
+	preferredID := fmt.Sprintf("preferred-rules-%d", time.Now().UnixNano())
+	otherID := fmt.Sprintf("other-rules-%d", time.Now().UnixNano())
+	requiredReviewers := 1
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Rules Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   blockedID,
+				UserName: fmt.Sprintf("Rules Blocked %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   preferredID,
+				UserName: fmt.Sprintf("Rules Preferred %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   otherID,
+				UserName: fmt.Sprintf("Rules Other %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.Rules().AddRule(s.T().Context(), rules.AddRuleParams{
+		Kind:       "never_review",
+		AuthorID:   authorID,
+		ReviewerID: blockedID,
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.Rules().AddRule(s.T().Context(), rules.AddRuleParams{
+		Kind:       "prefer",
+		AuthorID:   authorID,
+		ReviewerID: preferredID,
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.Rules().AddRule(s.T().Context(), rules.AddRuleParams{
+		Kind:       "prefer",
+		AuthorID:   authorID,
+		ReviewerID: authorID,
+	})
+	s.Error(err)
+
+	listResult, err := s.apiClient.Rules().ListRules(s.T().Context(), rules.ListRulesParams{
+		AuthorID: authorID,
+	})
+	s.NoError(err)
+	s.Len(listResult.Rules, 2)
+
+	prID := fmt.Sprintf("pr-rules-%d", time.Now().UnixNano())
+	prResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   prID,
+		PullRequestName: "Rules PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Equal([]string{preferredID}, prResult.PR.AssignedReviewers)
+
+	reassignResult, err := s.apiClient.PR().ReassignPR(s.T().Context(), pullrequests.ReassignPRParams{
+		PullRequestID: prID,
+		OldReviewerID: preferredID,
+	})
+	s.NoError(err)
+	s.Equal(otherID, reassignResult.ReplacedBy)
+
+	_, err = s.apiClient.PR().AddReviewer(s.T().Context(), pullrequests.AddReviewerParams{
+		PullRequestID: prID,
+		ReviewerID:    blockedID,
+	})
+	s.Error(err)
+	s.Contains(err.Error(), "REVIEWER_FORBIDDEN")
+
+	_, err = s.apiClient.Rules().DeleteRule(s.T().Context(), rules.DeleteRuleParams{
+		AuthorID:   authorID,
+		ReviewerID: blockedID,
+	})
+	s.NoError(err)
+
+	listResult, err = s.apiClient.Rules().ListRules(s.T().Context(), rules.ListRulesParams{
+		AuthorID: authorID,
+	})
+	s.NoError(err)
+	s.Len(listResult.Rules, 1)
+}
+
+func (s *E2ETestSuite) TestManualReviewers() {
+	teamName := fmt.Sprintf("team-manual-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-manual-%d", time.Now().UnixNano())
+	reviewerID := fmt.Sprintf("reviewer-manual-%d", time.Now().UnixNano())
+	inactiveID := fmt.Sprintf("inactive-manual-%d", time.Now().UnixNano())
+	requiredReviewers := 0
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Manual Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewerID,
+				UserName: fmt.Sprintf("Manual Reviewer %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   inactiveID,
+				UserName: fmt.Sprintf("Manual Inactive %d", time.Now().UnixNano()),
+				IsActive: false,
+			},
+		},
+	})
+	s.NoError(err)
+
+	prID := fmt.Sprintf("pr-manual-%d", time.Now().UnixNano())
+	prResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   prID,
+		PullRequestName: "Manual Reviewers PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Empty(prResult.PR.AssignedReviewers)
+
+	actorCtx := api.WithActor(s.T().Context(), authorID, "Нужен эксперт по модулю")
+
+	addResult, err := s.apiClient.PR().AddReviewer(actorCtx, pullrequests.AddReviewerParams{
+		PullRequestID: prID,
+		ReviewerID:    reviewerID,
+	})
+	s.NoError(err)
+	s.Equal([]string{reviewerID}, addResult.PR.AssignedReviewers)
+
+	for _, invalidID := range []string{reviewerID, authorID, inactiveID} {
+		_, err = s.apiClient.PR().AddReviewer(s.T().Context(), pullrequests.AddReviewerParams{
+			PullRequestID: prID,
+			ReviewerID:    invalidID,
+		})
+		s.Error(err)
+	}
+
+	_, err = s.apiClient.PR().RemoveReviewer(
+		api.WithActor(s.T().Context(), fmt.Sprintf("unknown-actor-%d", time.Now().UnixNano()), ""),
+		pullrequests.RemoveReviewerParams{
+			PullRequestID: prID,
+			ReviewerID:    reviewerID,
+		},
+	)
+	s.Error(err)
+
+	removeResult, err := s.apiClient.PR().RemoveReviewer(actorCtx, pullrequests.RemoveReviewerParams{
+		PullRequestID: prID,
+		ReviewerID:    reviewerID,
+	})
+	s.NoError(err)
+	s.Empty(removeResult.PR.AssignedReviewers)
+
+	_, err = s.apiClient.PR().RemoveReviewer(s.T().Context(), pullrequests.RemoveReviewerParams{
+		PullRequestID: prID,
+		ReviewerID:    reviewerID,
+	})
+	s.Error(err)
+
+	reason := "Вернули после обсуждения"
+	_, err = s.apiClient.PR().AddReviewer(
+		api.WithActor(s.T().Context(), "", reason),
+		pullrequests.AddReviewerParams{
+			PullRequestID: prID,
+			ReviewerID:    reviewerID,
+			ChangedBy:     authorID,
+		},
+	)
+	s.NoError(err)
+
+	historyResult, err := s.apiClient.PR().GetPRHistory(s.T().Context(), pullrequests.GetPRHistoryParams{
+		PullRequestID: prID,
+	})
+	s.NoError(err)
+	s.Require().NotEmpty(historyResult.History)
+
+	last := historyResult.History[len(historyResult.History)-1]
+	s.Equal(reviewerID, last.NewReviewerID)
+	s.Equal(authorID, last.ChangedBy)
+	s.Equal(reason, last.Comment)
+
+	_, err = s.apiClient.PR().MergePR(s.T().Context(), pullrequests.MergePRParams{
+		PullRequestID: prID,
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.PR().AddReviewer(s.T().Context(), pullrequests.AddReviewerParams{
+		PullRequestID: prID,
+		ReviewerID:    reviewerID,
+	})
+	s.Error(err)
+}
+
+func (s *E2ETestSuite) TestExplicitReassignment() {
+	teamName := fmt.Sprintf("team-explicit-%d", time.Now().UnixNano())
+	otherTeamName := fmt.Sprintf("team-explicit-other-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-explicit-%d", time.Now().UnixNano())
+	reviewerIDs := []string{
+		fmt.Sprintf("reviewer1-explicit-%d", time.Now().UnixNano()),
+		fmt.Sprintf("reviewer2-explicit-%d", time.Now().UnixNano()),
+	}
+	outsiderID := fmt.Sprintf("outsider-explicit-%d", time.Now().UnixNano())
+	requiredReviewers := 1
+
+	members := []teams.AddTeamParamsUser{
+		{
+			UserID:   authorID,
+			UserName: fmt.Sprintf("Explicit Author %d", time.Now().UnixNano()),
+			IsActive: true,
+		},
+	}
+	for _, reviewerID := range reviewerIDs {
+		members = append(members, teams.AddTeamParamsUser{
+			UserID:   reviewerID,
+			UserName: fmt.Sprintf("Explicit %s", reviewerID),
+			IsActive: true,
+		})
+	}
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+		Members:           members,
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: otherTeamName,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   outsiderID,
+				UserName: fmt.Sprintf("Explicit Outsider %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	prID := fmt.Sprintf("pr-explicit-%d", time.Now().UnixNano())
+	prResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   prID,
+		PullRequestName: "Explicit Reassignment PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Require().Len(prResult.PR.AssignedReviewers, 1)
+
+	oldReviewerID := prResult.PR.AssignedReviewers[0]
+	newReviewerID := reviewerIDs[0]
+	if newReviewerID == oldReviewerID {
+		newReviewerID = reviewerIDs[1]
+	}
+
+	for _, invalidID := range []string{authorID, outsiderID, oldReviewerID} {
+		_, err = s.apiClient.PR().ReassignPR(s.T().Context(), pullrequests.ReassignPRParams{
+			PullRequestID: prID,
+			OldReviewerID: oldReviewerID,
+			NewReviewerID: invalidID,
+		})
+		s.Error(err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	periodResult, err := s.apiClient.Users().AddUnavailability(s.T().Context(), users.AddUnavailabilityParams{
+		UserID:   newReviewerID,
+		StartsAt: now.Add(-time.Hour),
+		EndsAt:   now.Add(time.Hour),
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.PR().ReassignPR(s.T().Context(), pullrequests.ReassignPRParams{
+		PullRequestID: prID,
+		OldReviewerID: oldReviewerID,
+		NewReviewerID: newReviewerID,
+	})
+	s.Error(err)
+	s.Contains(err.Error(), "REVIEWER_UNAVAILABLE")
+
+	_, err = s.apiClient.PR().AddReviewer(s.T().Context(), pullrequests.AddReviewerParams{
+		PullRequestID: prID,
+		ReviewerID:    newReviewerID,
+	})
+	s.Error(err)
+	s.Contains(err.Error(), "REVIEWER_UNAVAILABLE")
+
+	_, err = s.apiClient.Users().DeleteUnavailability(s.T().Context(), users.DeleteUnavailabilityParams{
+		PeriodID: periodResult.Period.PeriodID,
+	})
+	s.NoError(err)
+
+	maxOpenReviews := 1
+	_, err = s.apiClient.Users().UpdateUser(s.T().Context(), users.UpdateUserParams{
+		UserID:         newReviewerID,
+		MaxOpenReviews: &maxOpenReviews,
+	})
+	s.NoError(err)
+
+	busyPRID := fmt.Sprintf("pr-explicit-busy-%d", time.Now().UnixNano())
+	busyResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   busyPRID,
+		PullRequestName: "Explicit Reassignment Busy PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	if !slices.Contains(busyResult.PR.AssignedReviewers, newReviewerID) {
+		_, err = s.apiClient.PR().AddReviewer(s.T().Context(), pullrequests.AddReviewerParams{
+			PullRequestID: busyPRID,
+			ReviewerID:    newReviewerID,
+		})
+		s.NoError(err)
+	}
+
+	_, err = s.apiClient.PR().ReassignPR(s.T().Context(), pullrequests.ReassignPRParams{
+		PullRequestID: prID,
+		OldReviewerID: oldReviewerID,
+		NewReviewerID: newReviewerID,
+	})
+	s.Error(err)
+	s.Contains(err.Error(), "REVIEWER_AT_CAPACITY")
+
+	_, err = s.apiClient.PR().AddReviewer(s.T().Context(), pullrequests.AddReviewerParams{
+		PullRequestID: prID,
+		ReviewerID:    newReviewerID,
+	})
+	s.Error(err)
+	s.Contains(err.Error(), "REVIEWER_AT_CAPACITY")
+
+	maxOpenReviews = 2
+	_, err = s.apiClient.Users().UpdateUser(s.T().Context(), users.UpdateUserParams{
+		UserID:         newReviewerID,
+		MaxOpenReviews: &maxOpenReviews,
+	})
+	s.NoError(err)
+
+	reassignResult, err := s.apiClient.PR().ReassignPR(s.T().Context(), pullrequests.ReassignPRParams{
+		PullRequestID: prID,
+		OldReviewerID: oldReviewerID,
+		NewReviewerID: newReviewerID,
+	})
+	s.NoError(err)
+	s.Equal(newReviewerID, reassignResult.ReplacedBy)
+	s.Equal([]string{newReviewerID}, reassignResult.PR.AssignedReviewers)
+}
+
+func (s *E2ETestSuite) TestPullRequestHistory() {
+	teamName := fmt.Sprintf("team-history-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-history-%d", time.Now().UnixNano())
+	reviewer1ID := fmt.Sprintf("reviewer1-history-%d", time.Now().UnixNano())
+	reviewer2ID := fmt.Sprintf("reviewer2-history-%d", time.Now().UnixNano())
+	requiredReviewers := 1
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("History Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewer1ID,
+				UserName: fmt.Sprintf("History Reviewer1 %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewer2ID,
+				UserName: fmt.Sprintf("History Reviewer2 %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	prID := fmt.Sprintf("pr-history-%d", time.Now().UnixNano())
+	prResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   prID,
+		PullRequestName: "History PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Require().Len(prResult.PR.AssignedReviewers, 1)
+
+	oldReviewerID := prResult.PR.AssignedReviewers[0]
+	newReviewerID := reviewer1ID
+	if newReviewerID == oldReviewerID {
+		newReviewerID = reviewer2ID
+	}
+
+	reason := "Ревьювер в отпуске"
+	_, err = s.apiClient.PR().ReassignPR(
+		api.WithActor(s.T().Context(), authorID, reason),
+		pullrequests.ReassignPRParams{
+			PullRequestID: prID,
+			OldReviewerID: oldReviewerID,
+			NewReviewerID: newReviewerID,
+		},
+	)
+	s.NoError(err)
+
+	historyResult, err := s.apiClient.PR().GetPRHistory(s.T().Context(), pullrequests.GetPRHistoryParams{
+		PullRequestID: prID,
+	})
+	s.NoError(err)
+	s.Equal(prID, historyResult.PullRequestID)
+	s.Require().Len(historyResult.History, 2)
+
+	initial := historyResult.History[0]
+	s.Equal("initial", initial.Reason)
+	s.Empty(initial.OldReviewerID)
+	s.Equal(oldReviewerID, initial.NewReviewerID)
+	s.Equal(authorID, initial.ChangedBy)
+
+	reassignment := historyResult.History[1]
+	s.Equal("reassignment", reassignment.Reason)
+	s.Equal(oldReviewerID, reassignment.OldReviewerID)
+	s.Equal(newReviewerID, reassignment.NewReviewerID)
+	s.Equal(authorID, reassignment.ChangedBy)
+	s.Equal(reason, reassignment.Comment)
+	s.False(reassignment.ChangedAt.Before(initial.ChangedAt))
+
+	_, err = s.apiClient.PR().GetPRHistory(s.T().Context(), pullrequests.GetPRHistoryParams{
+		PullRequestID: fmt.Sprintf("pr-history-missing-%d", time.Now().UnixNano()),
+	})
+	s.Error(err)
+}
+
+func (s *E2ETestSuite) TestPullRequestList() {
+	teamName := fmt.Sprintf("team-list-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-list-%d", time.Now().UnixNano())
+	reviewerID := fmt.Sprintf("reviewer-list-%d", time.Now().UnixNano())
+	requiredReviewers := 1
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("List Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewerID,
+				UserName: fmt.Sprintf("List Reviewer %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	prIDs := make([]string, 0, 3)
+	for i := range 3 {
+		prID := fmt.Sprintf("pr-list-%d-%d", i, time.Now().UnixNano())
+		_, err = s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+			PullRequestID:   prID,
+			PullRequestName: fmt.Sprintf("List PR %d", i),
+			AuthorID:        authorID,
+		})
+		s.NoError(err)
+
+		prIDs = append(prIDs, prID)
+	}
+
+	_, err = s.apiClient.PR().MergePR(s.T().Context(), pullrequests.MergePRParams{
+		PullRequestID: prIDs[0],
+	})
+	s.NoError(err)
+
+	getResult, err := s.apiClient.PR().GetPR(s.T().Context(), pullrequests.GetPRParams{
+		PullRequestID: prIDs[0],
+	})
+	s.NoError(err)
+	s.Equal(prIDs[0], getResult.PR.PullRequestID)
+	s.Equal(teamName, getResult.PR.TeamName)
+	s.Equal("MERGED", getResult.PR.Status)
+	s.Require().NotNil(getResult.PR.MergedAt)
+	s.Equal([]string{reviewerID}, getResult.PR.AssignedReviewers)
+
+	var (
+		listed []string
+		cursor string
+	)
+
+	for {
+		page, err := s.apiClient.PR().ListPRs(s.T().Context(), pullrequests.ListPRsParams{
+			AuthorID: authorID,
+			Cursor:   cursor,
+			Limit:    1,
+		})
+		s.Require().NoError(err)
+		s.LessOrEqual(len(page.PullRequests), 1)
+
+		for _, pr := range page.PullRequests {
+			listed = append(listed, pr.PullRequestID)
+		}
+
+		if page.NextCursor == "" {
+			break
+		}
+
+		cursor = page.NextCursor
+	}
+
+	s.Equal(prIDs, listed)
+
+	openResult, err := s.apiClient.PR().ListPRs(s.T().Context(), pullrequests.ListPRsParams{
+		Status:     "OPEN",
+		TeamName:   teamName,
+		ReviewerID: reviewerID,
+	})
+	s.NoError(err)
+	s.Require().Len(openResult.PullRequests, 2)
+	s.Equal(prIDs[1], openResult.PullRequests[0].PullRequestID)
+	s.Equal(prIDs[2], openResult.PullRequests[1].PullRequestID)
+	s.Empty(openResult.NextCursor)
+
+	mergedFrom := getResult.PR.CreatedAt
+	mergedResult, err := s.apiClient.PR().ListPRs(s.T().Context(), pullrequests.ListPRsParams{
+		AuthorID:   authorID,
+		MergedFrom: &mergedFrom,
+	})
+	s.NoError(err)
+	s.Require().Len(mergedResult.PullRequests, 1)
+	s.Equal(prIDs[0], mergedResult.PullRequests[0].PullRequestID)
+
+	_, err = s.apiClient.PR().ListPRs(s.T().Context(), pullrequests.ListPRsParams{
+		Status: "UNKNOWN",
+	})
+	s.Error(err)
+
+	_, err = s.apiClient.PR().ListPRs(s.T().Context(), pullrequests.ListPRsParams{
+		Cursor: "not-a-cursor",
+	})
+	s.Error(err)
+}
+
+func (s *E2ETestSuite) TestGetReviewPagination() {
+	teamName := fmt.Sprintf("team-get-review-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-get-review-%d", time.Now().UnixNano())
+	reviewerID := fmt.Sprintf("reviewer-get-review-%d", time.Now().UnixNano())
+	requiredReviewers := 1
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Get Review Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewerID,
+				UserName: fmt.Sprintf("Get Review Reviewer %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	prIDs := make([]string, 0, 3)
+	for i := range 3 {
+		prID := fmt.Sprintf("pr-get-review-%d-%d", i, time.Now().UnixNano())
+		_, err = s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+			PullRequestID:   prID,
+			PullRequestName: fmt.Sprintf("Get Review PR %d", i),
+			AuthorID:        authorID,
+		})
+		s.NoError(err)
+
+		prIDs = append(prIDs, prID)
+	}
+
+	_, err = s.apiClient.PR().MergePR(s.T().Context(), pullrequests.MergePRParams{
+		PullRequestID: prIDs[0],
+	})
+	s.NoError(err)
+
+	firstPage, err := s.apiClient.Users().GetReviewPRs(s.T().Context(), users.GetReviewPRsParams{
+		UserID: reviewerID,
+		Limit:  1,
+	})
+	s.NoError(err)
+	s.Require().Len(firstPage.PullRequests, 1)
+	s.Equal(prIDs[1], firstPage.PullRequests[0].PullRequestID)
+	s.Equal(authorID, firstPage.PullRequests[0].AuthorID)
+	s.Equal("OPEN", firstPage.PullRequests[0].Status)
+	s.Require().NotEmpty(firstPage.NextCursor)
+
+	secondPage, err := s.apiClient.Users().GetReviewPRs(s.T().Context(), users.GetReviewPRsParams{
+		UserID: reviewerID,
+		Cursor: firstPage.NextCursor,
+		Limit:  1,
+	})
+	s.NoError(err)
+	s.Require().Len(secondPage.PullRequests, 1)
+	s.Equal(prIDs[2], secondPage.PullRequests[0].PullRequestID)
+	s.False(secondPage.PullRequests[0].AssignedAt.Before(firstPage.PullRequests[0].AssignedAt))
+	s.Empty(secondPage.NextCursor)
+
+	mergedResult, err := s.apiClient.Users().GetReviewPRs(s.T().Context(), users.GetReviewPRsParams{
+		UserID: reviewerID,
+		Status: "MERGED",
+	})
+	s.NoError(err)
+	s.Require().Len(mergedResult.PullRequests, 1)
+	s.Equal(prIDs[0], mergedResult.PullRequests[0].PullRequestID)
+
+	_, err = s.apiClient.Users().GetReviewPRs(s.T().Context(), users.GetReviewPRsParams{
+		UserID: reviewerID,
+		Limit:  1000,
+	})
+	s.Error(err)
+}
+
+func (s *E2ETestSuite) TestUserDirectory() {
+	teamName := fmt.Sprintf("team-directory-%d", time.Now().UnixNano())
+	user1ID := fmt.Sprintf("user1-directory-%d", time.Now().UnixNano())
+	user2ID := fmt.Sprintf("user2-directory-%d", time.Now().UnixNano())
+	user1Email := fmt.Sprintf("user1-%d@corp.test", time.Now().UnixNano())
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: teamName,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   user1ID,
+				UserName: fmt.Sprintf("Directory User1 %d", time.Now().UnixNano()),
+				Email:    user1Email,
+				IsActive: true,
+			},
+			{
+				UserID:   user2ID,
+				UserName: fmt.Sprintf("Directory User2 %d", time.Now().UnixNano()),
+				IsActive: false,
+			},
+		},
+	})
+	s.NoError(err)
+
+	getResult, err := s.apiClient.Users().GetUser(s.T().Context(), users.GetUserParams{
+		UserID: user1ID,
+	})
+	s.NoError(err)
+	s.Equal(user1Email, getResult.User.Email)
+	s.Equal(teamName, getResult.User.TeamName)
+
+	user2Result, err := s.apiClient.Users().GetUser(s.T().Context(), users.GetUserParams{
+		UserID: user2ID,
+	})
+	s.NoError(err)
+	s.Empty(user2Result.User.Email)
+
+	isActive := true
+	listResult, err := s.apiClient.Users().ListUsers(s.T().Context(), users.ListUsersParams{
+		TeamName: teamName,
+		IsActive: &isActive,
+	})
+	s.NoError(err)
+	s.Require().Len(listResult.Users, 1)
+	s.Equal(user1ID, listResult.Users[0].UserID)
+
+	firstPage, err := s.apiClient.Users().ListUsers(s.T().Context(), users.ListUsersParams{
+		TeamName: teamName,
+		Limit:    1,
+	})
+	s.NoError(err)
+	s.Require().Len(firstPage.Users, 1)
+	s.Require().NotEmpty(firstPage.NextCursor)
+
+	secondPage, err := s.apiClient.Users().ListUsers(s.T().Context(), users.ListUsersParams{
+		TeamName: teamName,
+		Cursor:   firstPage.NextCursor,
+		Limit:    1,
+	})
+	s.NoError(err)
+	s.Require().Len(secondPage.Users, 1)
+	s.NotEqual(firstPage.Users[0].UserID, secondPage.Users[0].UserID)
+	s.Empty(secondPage.NextCursor)
+
+	newUser2ID := fmt.Sprintf("user2-directory-renamed-%d", time.Now().UnixNano())
+	newUsername := fmt.Sprintf("Directory Renamed %d", time.Now().UnixNano())
+	user2Email := fmt.Sprintf("user2-%d@corp.test", time.Now().UnixNano())
+	updateResult, err := s.apiClient.Users().UpdateUser(s.T().Context(), users.UpdateUserParams{
+		UserID:    user2ID,
+		NewUserID: &newUser2ID,
+		Username:  &newUsername,
+		Email:     &user2Email,
+	})
+	s.NoError(err)
+	s.Equal(newUser2ID, updateResult.User.UserID)
+	s.Equal(newUsername, updateResult.User.Username)
+	s.Equal(user2Email, updateResult.User.Email)
+	s.Equal(teamName, updateResult.User.TeamName)
+
+	_, err = s.apiClient.Users().GetUser(s.T().Context(), users.GetUserParams{UserID: user2ID})
+	s.Error(err)
+
+	_, err = s.apiClient.Users().UpdateUser(s.T().Context(), users.UpdateUserParams{
+		UserID: newUser2ID,
+		Email:  &user1Email,
+	})
+	s.Error(err)
+
+	_, err = s.apiClient.Users().UpdateUser(s.T().Context(), users.UpdateUserParams{
+		UserID:    newUser2ID,
+		NewUserID: &user1ID,
+	})
+	s.Error(err)
+
+	invalidEmail := "not-an-email"
+	_, err = s.apiClient.Users().UpdateUser(s.T().Context(), users.UpdateUserParams{
+		UserID: newUser2ID,
+		Email:  &invalidEmail,
+	})
+	s.Error(err)
+
+	emptyEmail := ""
+	clearResult, err := s.apiClient.Users().UpdateUser(s.T().Context(), users.UpdateUserParams{
+		UserID: newUser2ID,
+		Email:  &emptyEmail,
+	})
+	s.NoError(err)
+	s.Empty(clearResult.User.Email)
+}
+
+func (s *E2ETestSuite) TestPullRequestCloseReopen() {
+	teamName := fmt.Sprintf("team-close-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-close-%d", time.Now().UnixNano())
+	reviewer1ID := fmt.Sprintf("reviewer1-close-%d", time.Now().UnixNano())
+	reviewer2ID := fmt.Sprintf("reviewer2-close-%d", time.Now().UnixNano())
+	requiredReviewers := 1
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Close Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewer1ID,
+				UserName: fmt.Sprintf("Close Reviewer1 %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewer2ID,
+				UserName: fmt.Sprintf("Close Reviewer2 %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	prID := fmt.Sprintf("pr-close-%d", time.Now().UnixNano())
+	createResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   prID,
+		PullRequestName: "Close PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Require().Len(createResult.PR.AssignedReviewers, 1)
+
+	oldReviewerID := createResult.PR.AssignedReviewers[0]
+	otherReviewerID := reviewer1ID
+	if otherReviewerID == oldReviewerID {
+		otherReviewerID = reviewer2ID
+	}
+
+	closeResult, err := s.apiClient.PR().ClosePR(s.T().Context(), pullrequests.ClosePRParams{
+		PullRequestID: prID,
+	})
+	s.NoError(err)
+	s.Equal("CLOSED", closeResult.PR.Status)
+	s.Equal([]string{oldReviewerID}, closeResult.PR.AssignedReviewers)
+
+	_, err = s.apiClient.PR().ClosePR(s.T().Context(), pullrequests.ClosePRParams{
+		PullRequestID: prID,
+	})
+	s.NoError(err)
+
+	reviewResult, err := s.apiClient.Users().GetReviewPRs(s.T().Context(), users.GetReviewPRsParams{
+		UserID: oldReviewerID,
+	})
+	s.NoError(err)
+	s.Empty(reviewResult.PullRequests)
+
+	_, err = s.apiClient.PR().AddReviewer(s.T().Context(), pullrequests.AddReviewerParams{
+		PullRequestID: prID,
+		ReviewerID:    otherReviewerID,
+	})
+	s.Error(err)
+
+	_, err = s.apiClient.PR().MergePR(s.T().Context(), pullrequests.MergePRParams{
+		PullRequestID: prID,
+	})
+	s.Error(err)
+
+	_, err = s.apiClient.PR().MarkReadyPR(s.T().Context(), pullrequests.MarkReadyPRParams{
+		PullRequestID: prID,
+	})
+	s.Error(err)
+
+	// Ревьювер, деактивированный пока PR закрыт, заменяется при переоткрытии
+	_, err = s.apiClient.Users().SetIsActive(s.T().Context(), users.SetIsActiveParams{
+		UserID:   oldReviewerID,
+		IsActive: false,
+	})
+	s.NoError(err)
+
+	reopenResult, err := s.apiClient.PR().ReopenPR(s.T().Context(), pullrequests.ReopenPRParams{
+		PullRequestID: prID,
+	})
+	s.NoError(err)
+	s.Equal("OPEN", reopenResult.PR.Status)
+	s.Equal([]string{otherReviewerID}, reopenResult.PR.AssignedReviewers)
+	s.False(reopenResult.PR.NeedMoreReviewers)
+
+	historyResult, err := s.apiClient.PR().GetPRHistory(s.T().Context(), pullrequests.GetPRHistoryParams{
+		PullRequestID: prID,
+	})
+	s.NoError(err)
+	s.Require().Len(historyResult.History, 3)
+	s.Equal("deactivation", historyResult.History[1].Reason)
+	s.Equal(oldReviewerID, historyResult.History[1].OldReviewerID)
+	s.Equal("top_up", historyResult.History[2].Reason)
+	s.Equal(otherReviewerID, historyResult.History[2].NewReviewerID)
+
+	// PR с ревьюверами сверх required_reviewers переоткрывается без изменения состава
+	_, err = s.apiClient.Users().SetIsActive(s.T().Context(), users.SetIsActiveParams{
+		UserID:   oldReviewerID,
+		IsActive: true,
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.PR().AddReviewer(s.T().Context(), pullrequests.AddReviewerParams{
+		PullRequestID: prID,
+		ReviewerID:    oldReviewerID,
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.PR().ClosePR(s.T().Context(), pullrequests.ClosePRParams{
+		PullRequestID: prID,
+	})
+	s.NoError(err)
+
+	reopenResult, err = s.apiClient.PR().ReopenPR(s.T().Context(), pullrequests.ReopenPRParams{
+		PullRequestID: prID,
+	})
+	s.NoError(err)
+	s.Equal("OPEN", reopenResult.PR.Status)
+	s.ElementsMatch([]string{otherReviewerID, oldReviewerID}, reopenResult.PR.AssignedReviewers)
+	s.False(reopenResult.PR.NeedMoreReviewers)
+
+	mergeResult, err := s.apiClient.PR().MergePR(s.T().Context(), pullrequests.MergePRParams{
+		PullRequestID: prID,
+	})
+	s.NoError(err)
+	s.Equal("MERGED", mergeResult.PR.Status)
+
+	_, err = s.apiClient.PR().ClosePR(s.T().Context(), pullrequests.ClosePRParams{
+		PullRequestID: prID,
+	})
+	s.Error(err)
+
+	_, err = s.apiClient.PR().ReopenPR(s.T().Context(), pullrequests.ReopenPRParams{
+		PullRequestID: prID,
+	})
+	s.Error(err)
+}
+
+func (s *E2ETestSuite) TestDraftPullRequest() {
+	teamName := fmt.Sprintf("team-draft-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-draft-%d", time.Now().UnixNano())
+	reviewerID := fmt.Sprintf("reviewer-draft-%d", time.Now().UnixNano())
+	requiredReviewers := 1
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Draft Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewerID,
+				UserName: fmt.Sprintf("Draft Reviewer %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	prID := fmt.Sprintf("pr-draft-%d", time.Now().UnixNano())
+	createResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   prID,
+		PullRequestName: "Draft PR",
+		AuthorID:        authorID,
+		IsDraft:         true,
+	})
+	s.NoError(err)
+	s.Equal("DRAFT", createResult.PR.Status)
+	s.Empty(createResult.PR.AssignedReviewers)
+	s.False(createResult.PR.NeedMoreReviewers)
+
+	_, err = s.apiClient.PR().AddReviewer(s.T().Context(), pullrequests.AddReviewerParams{
+		PullRequestID: prID,
+		ReviewerID:    reviewerID,
+	})
+	s.Error(err)
+
+	listResult, err := s.apiClient.PR().ListPRs(s.T().Context(), pullrequests.ListPRsParams{
+		Status:   "DRAFT",
+		AuthorID: authorID,
+	})
+	s.NoError(err)
+	s.Require().Len(listResult.PullRequests, 1)
+	s.Equal(prID, listResult.PullRequests[0].PullRequestID)
+	s.Equal(teamName, listResult.PullRequests[0].TeamName)
+
+	readyResult, err := s.apiClient.PR().MarkReadyPR(s.T().Context(), pullrequests.MarkReadyPRParams{
+		PullRequestID: prID,
+	})
+	s.NoError(err)
+	s.Equal("OPEN", readyResult.PR.Status)
+	s.Equal([]string{reviewerID}, readyResult.PR.AssignedReviewers)
+	s.False(readyResult.PR.NeedMoreReviewers)
+
+	_, err = s.apiClient.PR().MarkReadyPR(s.T().Context(), pullrequests.MarkReadyPRParams{
+		PullRequestID: prID,
+	})
+	s.NoError(err)
+
+	historyResult, err := s.apiClient.PR().GetPRHistory(s.T().Context(), pullrequests.GetPRHistoryParams{
+		PullRequestID: prID,
+	})
+	s.NoError(err)
+	s.Require().Len(historyResult.History, 1)
+	s.Equal("initial", historyResult.History[0].Reason)
+	s.Equal(reviewerID, historyResult.History[0].NewReviewerID)
+	s.Equal(authorID, historyResult.History[0].ChangedBy)
+
+	closedDraftID := fmt.Sprintf("pr-draft-closed-%d", time.Now().UnixNano())
+	_, err = s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   closedDraftID,
+		PullRequestName: "Closed Draft PR",
+		AuthorID:        authorID,
+		IsDraft:         true,
+	})
+	s.NoError(err)
+
+	_, err = s.apiClient.PR().ClosePR(s.T().Context(), pullrequests.ClosePRParams{
+		PullRequestID: closedDraftID,
+	})
+	s.NoError(err)
+
+	reopenResult, err := s.apiClient.PR().ReopenPR(s.T().Context(), pullrequests.ReopenPRParams{
+		PullRequestID: closedDraftID,
+	})
+	s.NoError(err)
+	s.Equal("OPEN", reopenResult.PR.Status)
+	s.Equal([]string{reviewerID}, reopenResult.PR.AssignedReviewers)
+
+	historyResult, err = s.apiClient.PR().GetPRHistory(s.T().Context(), pullrequests.GetPRHistoryParams{
+		PullRequestID: closedDraftID,
+	})
+	s.NoError(err)
+	s.Require().Len(historyResult.History, 1)
+	s.Equal("initial", historyResult.History[0].Reason)
+	s.Equal(authorID, historyResult.History[0].ChangedBy)
+}
+
+func (s *E2ETestSuite) TestReviewerCapacity() {
+	teamName := fmt.Sprintf("team-capacity-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-capacity-%d", time.Now().UnixNano())
+	reviewerID := fmt.Sprintf("reviewer-capacity-%d", time.Now().UnixNano())
+	requiredReviewers := 1
+	maxOpenReviews := 1
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Capacity Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewerID,
+				UserName: fmt.Sprintf("Capacity Reviewer %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	settingsResult, err := s.apiClient.Teams().SetTeamSettings(s.T().Context(), teams.SetTeamSettingsParams{
+		TeamName:       teamName,
+		MaxOpenReviews: &maxOpenReviews,
+	})
+	s.NoError(err)
+	s.Equal(maxOpenReviews, settingsResult.Team.MaxOpenReviews)
+
+	firstResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   fmt.Sprintf("pr-capacity-1-%d", time.Now().UnixNano()),
+		PullRequestName: "Capacity PR 1",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Equal([]string{reviewerID}, firstResult.PR.AssignedReviewers)
+	s.False(firstResult.PR.NeedMoreReviewers)
+
+	loadResult, err := s.apiClient.Users().GetUserLoad(s.T().Context(), users.GetUserLoadParams{
+		UserID: reviewerID,
+	})
+	s.NoError(err)
+	s.Equal(int64(1), loadResult.OpenReviews)
+	s.Equal(maxOpenReviews, loadResult.MaxOpenReviews)
+	s.True(loadResult.AtCapacity)
+
+	secondResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   fmt.Sprintf("pr-capacity-2-%d", time.Now().UnixNano()),
+		PullRequestName: "Capacity PR 2",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Empty(secondResult.PR.AssignedReviewers)
+	s.True(secondResult.PR.NeedMoreReviewers)
+
+	userMaxOpenReviews := 2
+	_, err = s.apiClient.Users().UpdateUser(s.T().Context(), users.UpdateUserParams{
+		UserID:         reviewerID,
+		MaxOpenReviews: &userMaxOpenReviews,
+	})
+	s.NoError(err)
+
+	thirdResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   fmt.Sprintf("pr-capacity-3-%d", time.Now().UnixNano()),
+		PullRequestName: "Capacity PR 3",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Equal([]string{reviewerID}, thirdResult.PR.AssignedReviewers)
+
+	loadResult, err = s.apiClient.Users().GetUserLoad(s.T().Context(), users.GetUserLoadParams{
+		UserID: reviewerID,
+	})
+	s.NoError(err)
+	s.Equal(int64(2), loadResult.OpenReviews)
+	s.Equal(userMaxOpenReviews, loadResult.MaxOpenReviews)
+	s.True(loadResult.AtCapacity)
+
+	negative := -1
+	_, err = s.apiClient.Users().UpdateUser(s.T().Context(), users.UpdateUserParams{
+		UserID:         reviewerID,
+		MaxOpenReviews: &negative,
+	})
+	s.Error(err)
+}
+
+func (s *E2ETestSuite) TestUserAvailability() {
+	teamName := fmt.Sprintf("team-availability-%d", time.Now().UnixNano())
+	authorID := fmt.Sprintf("author-availability-%d", time.Now().UnixNano())
+	awayID := fmt.Sprintf("away-availability-%d", time.Now().UnixNano())
+	reviewerID := fmt.Sprintf("reviewer-availability-%d", time.Now().UnixNano())
+	requiredReviewers := 1
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   authorID,
+				UserName: fmt.Sprintf("Availability Author %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   awayID,
+				UserName: fmt.Sprintf("Availability Away %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+			{
+				UserID:   reviewerID,
+				UserName: fmt.Sprintf("Availability Reviewer %d", time.Now().UnixNano()),
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	_, err = s.apiClient.Users().AddUnavailability(s.T().Context(), users.AddUnavailabilityParams{
+		UserID:   awayID,
+		StartsAt: now,
+		EndsAt:   now.Add(-time.Hour),
+	})
+	s.Error(err)
+
+	addResult, err := s.apiClient.Users().AddUnavailability(s.T().Context(), users.AddUnavailabilityParams{
+		UserID:   awayID,
+		StartsAt: now.Add(-time.Hour),
+		EndsAt:   now.Add(24 * time.Hour),
+		Reason:   "vacation",
+	})
+	s.NoError(err)
+	s.Equal(awayID, addResult.Period.UserID)
+	s.Equal("vacation", addResult.Period.Reason)
+	s.True(addResult.Period.EndsAt.Equal(now.Add(24 * time.Hour)))
+
+	for i := range 3 {
+		createResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+			PullRequestID:   fmt.Sprintf("pr-availability-%d-%d", i, time.Now().UnixNano()),
+			PullRequestName: "Availability PR",
+			AuthorID:        authorID,
+		})
+		s.NoError(err)
+		s.Equal([]string{reviewerID}, createResult.PR.AssignedReviewers)
+	}
+
+	reason := "sick leave"
+	updateResult, err := s.apiClient.Users().UpdateUnavailability(s.T().Context(), users.UpdateUnavailabilityParams{
+		PeriodID: addResult.Period.PeriodID,
+		Reason:   &reason,
+	})
+	s.NoError(err)
+	s.Equal(reason, updateResult.Period.Reason)
+
+	listResult, err := s.apiClient.Users().ListUnavailability(s.T().Context(), users.ListUnavailabilityParams{
+		UserID: awayID,
+	})
+	s.NoError(err)
+	s.Require().Len(listResult.Periods, 1)
+	s.Equal(addResult.Period.PeriodID, listResult.Periods[0].PeriodID)
+
+	_, err = s.apiClient.Users().DeleteUnavailability(s.T().Context(), users.DeleteUnavailabilityParams{
+		PeriodID: addResult.Period.PeriodID,
+	})
+	s.NoError(err)
+
+	listResult, err = s.apiClient.Users().ListUnavailability(s.T().Context(), users.ListUnavailabilityParams{
+		UserID: awayID,
+	})
+	s.NoError(err)
+	s.Empty(listResult.Periods)
+
+	_, err = s.apiClient.Users().DeleteUnavailability(s.T().Context(), users.DeleteUnavailabilityParams{
+		PeriodID: addResult.Period.PeriodID,
+	})
+	s.Error(err)
+}
+
+func (s *E2ETestSuite) TestUnavailabilityImport() {
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("team-ics-%d", suffix)
+	awayID := fmt.Sprintf("away-ics-%d", suffix)
+	awayEmail := fmt.Sprintf("away-ics-%d@example.com", suffix)
+	unknownEmail := fmt.Sprintf("unknown-ics-%d@example.com", suffix)
+	uid := fmt.Sprintf("ooo-%d@example.com", suffix)
+
+	_, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName: teamName,
+		Members: []teams.AddTeamParamsUser{
+			{
+				UserID:   awayID,
+				UserName: fmt.Sprintf("ICS Away %d", suffix),
+				Email:    awayEmail,
+				IsActive: true,
+			},
+		},
+	})
+	s.NoError(err)
+
+	calendar := func(extra string) []byte {
+		return []byte(strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"VERSION:2.0",
+			"PRODID:-//e2e//EN",
+			"BEGIN:VEVENT",
+			"UID:" + uid,
+			"DTSTART;VALUE=DATE:20300101",
+			"DTEND;VALUE=DATE:20300108",
+			"SUMMARY:Vacation",
+			"X-MICROSOFT-CDO-BUSYSTATUS:OOF",
+			"ATTENDEE;PARTSTAT=ACCEPTED:mailto:" + strings.ToUpper(awayEmail),
+			"ATTENDEE:mailto:" + unknownEmail,
+			extra,
+			"END:VEVENT",
+			"END:VCALENDAR",
+			"",
+		}, "\r\n"))
+	}
+
+	_, err = s.apiClient.Users().ImportUnavailability(s.T().Context(), users.ImportUnavailabilityParams{
+		Calendar: []byte("BEGIN:VEVENT\r\n"),
+	})
+	s.Error(err)
+
+	importResult, err := s.apiClient.Users().ImportUnavailability(s.T().Context(), users.ImportUnavailabilityParams{
+		Calendar: calendar("SEQUENCE:0"),
+	})
+	s.NoError(err)
+	s.Equal(1, importResult.Created)
+	s.Equal([]string{unknownEmail}, importResult.UnmatchedEmails)
+
+	importResult, err = s.apiClient.Users().ImportUnavailability(s.T().Context(), users.ImportUnavailabilityParams{
+		Calendar: calendar("SEQUENCE:1"),
+	})
+	s.NoError(err)
+	s.Equal(0, importResult.Created)
+	s.Equal(1, importResult.Updated)
+
+	listResult, err := s.apiClient.Users().ListUnavailability(s.T().Context(), users.ListUnavailabilityParams{
+		UserID: awayID,
+	})
+	s.NoError(err)
+	s.Require().Len(listResult.Periods, 1)
+	s.Equal(uid, listResult.Periods[0].ExternalUID)
+	s.Equal("Vacation", listResult.Periods[0].Reason)
+	s.True(listResult.Periods[0].StartsAt.Equal(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	importResult, err = s.apiClient.Users().ImportUnavailability(s.T().Context(), users.ImportUnavailabilityParams{
+		Calendar: calendar("STATUS:CANCELLED"),
+	})
+	s.NoError(err)
+	s.Equal(1, importResult.Deleted)
+
+	listResult, err = s.apiClient.Users().ListUnavailability(s.T().Context(), users.ListUnavailabilityParams{
+		UserID: awayID,
+	})
+	s.NoError(err)
+	s.Empty(listResult.Periods)
+}
+
+func (s *E2ETestSuite) TestWorkingHoursAssignment() {
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("team-working-hours-%d", suffix)
+	authorID := fmt.Sprintf("author-working-hours-%d", suffix)
+	firstID := fmt.Sprintf("first-working-hours-%d", suffix)
+	secondID := fmt.Sprintf("second-working-hours-%d", suffix)
+	requiredReviewers := 1
+
+	addResult, err := s.apiClient.Teams().AddTeam(s.T().Context(), teams.AddTeamParams{
+		TeamName:          teamName,
+		RequiredReviewers: &requiredReviewers,
+		Members: []teams.AddTeamParamsUser{
+			{UserID: authorID, UserName: fmt.Sprintf("Working Hours Author %d", suffix), IsActive: true},
+			{UserID: firstID, UserName: fmt.Sprintf("Working Hours First %d", suffix), IsActive: true},
+			{UserID: secondID, UserName: fmt.Sprintf("Working Hours Second %d", suffix), IsActive: true},
+		},
+	})
+	s.NoError(err)
+	s.False(addResult.Team.PreferWorkingHours)
+
+	preferWorkingHours := true
+	settingsResult, err := s.apiClient.Teams().SetTeamSettings(s.T().Context(), teams.SetTeamSettingsParams{
+		TeamName:           teamName,
+		PreferWorkingHours: &preferWorkingHours,
+	})
+	s.NoError(err)
+	s.True(settingsResult.Team.PreferWorkingHours)
+
+	const timezone = "Asia/Tokyo"
+
+	location, err := time.LoadLocation(timezone)
+	s.Require().NoError(err)
+
+	// weekdayIn возвращает название дня недели, который наступит через days дней в часовом поясе.
+	weekdayIn := func(days int) string {
+		return strings.ToLower(time.Now().In(location).AddDate(0, 0, days).Weekday().String())
+	}
+
+	_, err = s.apiClient.Users().SetUserSchedule(s.T().Context(), users.SetUserScheduleParams{
+		UserID:   firstID,
+		Timezone: "Mars/Olympus",
+	})
+	s.Error(err)
+
+	_, err = s.apiClient.Users().SetUserSchedule(s.T().Context(), users.SetUserScheduleParams{
+		UserID: firstID,
+		WorkingHours: []users.WorkingHours{
+			{Weekday: "monday", Start: "18:00", End: "09:00"},
+		},
+	})
+	s.Error(err)
+
+	setResult, err := s.apiClient.Users().SetUserSchedule(s.T().Context(), users.SetUserScheduleParams{
+		UserID:   firstID,
+		Timezone: timezone,
+		WorkingHours: []users.WorkingHours{
+			{Weekday: weekdayIn(2), Start: "09:00", End: "18:00"},
+		},
+	})
+	s.NoError(err)
+	s.Equal(timezone, setResult.Schedule.Timezone)
+	s.Require().Len(setResult.Schedule.WorkingHours, 1)
+	s.False(setResult.Schedule.InWorkingHours)
+	s.Require().NotNil(setResult.Schedule.NextWorkingAt)
+	s.True(setResult.Schedule.NextWorkingAt.After(time.Now()))
+
+	// Второй ревьювер без рабочих часов доступен в любое время.
+	for i := range 3 {
+		createResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+			PullRequestID:   fmt.Sprintf("pr-working-hours-%d-%d", i, time.Now().UnixNano()),
+			PullRequestName: "Working Hours PR",
+			AuthorID:        authorID,
+		})
+		s.NoError(err)
+		s.Equal([]string{secondID}, createResult.PR.AssignedReviewers)
+	}
+
+	// Рабочие часы первого ревьювера начнутся раньше, чем у второго.
+	_, err = s.apiClient.Users().SetUserSchedule(s.T().Context(), users.SetUserScheduleParams{
+		UserID:   secondID,
+		Timezone: timezone,
+		WorkingHours: []users.WorkingHours{
+			{Weekday: weekdayIn(4), Start: "09:00", End: "18:00"},
+		},
+	})
+	s.NoError(err)
+
+	for i := range 3 {
+		createResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+			PullRequestID:   fmt.Sprintf("pr-working-hours-next-%d-%d", i, time.Now().UnixNano()),
+			PullRequestName: "Working Hours PR",
+			AuthorID:        authorID,
+		})
+		s.NoError(err)
+		s.Equal([]string{firstID}, createResult.PR.AssignedReviewers)
+	}
+
+	allDay := make([]users.WorkingHours, 0, 7)
+	for days := range 7 {
+		allDay = append(allDay, users.WorkingHours{Weekday: weekdayIn(days), Start: "00:00", End: "24:00"})
+	}
+
+	_, err = s.apiClient.Users().SetUserSchedule(s.T().Context(), users.SetUserScheduleParams{
+		UserID:       secondID,
+		Timezone:     timezone,
+		WorkingHours: allDay,
+	})
+	s.NoError(err)
+
+	getResult, err := s.apiClient.Users().GetUserSchedule(s.T().Context(), users.GetUserScheduleParams{
+		UserID: secondID,
+	})
+	s.NoError(err)
+	s.True(getResult.Schedule.InWorkingHours)
+	s.Nil(getResult.Schedule.NextWorkingAt)
+	s.Len(getResult.Schedule.WorkingHours, 7)
+	s.Equal("monday", getResult.Schedule.WorkingHours[0].Weekday)
+
+	createResult, err := s.apiClient.PR().CreatePR(s.T().Context(), pullrequests.CreatePRParams{
+		PullRequestID:   fmt.Sprintf("pr-working-hours-all-day-%d", time.Now().UnixNano()),
+		PullRequestName: "Working Hours PR",
+		AuthorID:        authorID,
+	})
+	s.NoError(err)
+	s.Equal([]string{secondID}, createResult.PR.AssignedReviewers)
+}